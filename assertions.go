@@ -2,25 +2,45 @@ package assert
 
 import (
 	"errors"
-	"io"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 )
 
 // Option config Assertions in flying.
 type Option func(it *Assertions)
 
-// WithFailFast sets fail fast of assertion.
+// WithFailFast sets fail fast of assertion, which quits the test once any assertion of the Assertions fails.
 func WithFailFast(failFast bool) Option {
 	return func(it *Assertions) {
-		it.fast = failFast
+		it.updateSettings(func(settings *settings) {
+			settings.fast = failFast
+		})
+	}
+}
+
+// WithCompareOptions sets options applied by Equal, NotEqual, EqualWith and NotEqualWith.
+func WithCompareOptions(options ...CompareOption) Option {
+	return func(it *Assertions) {
+		it.compare = append(it.compare, options...)
+	}
+}
+
+// WithReaderLimit sets max size of data read by reader assertions, it is ReaderLimit by default.
+func WithReaderLimit(limit int64) Option {
+	return func(it *Assertions) {
+		it.readerLimit = &limit
 	}
 }
 
 // Assertions provides asserts around the
 // Testing interface.
 type Assertions struct {
-	t    Testing
-	fast bool
+	t           Testing
+	compare     []CompareOption
+	readerLimit *int64
+	settings    *settings
 }
 
 // New creates a new *Assertions for the Testing.
@@ -32,9 +52,21 @@ func New(t Testing, options ...Option) *Assertions {
 		opt(it)
 	}
 
+	// NOTE: settings are carried by the Testing down to Fail, like options of compare
+	it.t = withSettings(t, it.settings)
+
 	return it
 }
 
+// updateSettings updates settings of failures reported through the Assertions.
+func (it *Assertions) updateSettings(update func(settings *settings)) {
+	if it.settings == nil {
+		it.settings = new(settings)
+	}
+
+	update(it.settings)
+}
+
 // NewRequire creates a new *Assertions with fail fast mode for the Testing.
 func NewRequire(t Testing) *Assertions {
 	return New(t, WithFailFast(true))
@@ -42,24 +74,92 @@ func NewRequire(t Testing) *Assertions {
 
 // Fail reports a failure through
 func (it *Assertions) Fail(message string, formatAndArgs ...interface{}) bool {
-	if it.fast {
-		return it.FailNow(message, formatAndArgs...)
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
 	}
 
+	// NOTE: the Testing quits in fail fast mode after reporting
 	return Fail(it.t, message, formatAndArgs...)
 }
 
 // FailNow fails test
 func (it *Assertions) FailNow(message string, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return FailNow(it.t, message, formatAndArgs...)
 }
 
+// Name returns name of the running test, or empty if the Testing doesn't implement Name.
+func (it *Assertions) Name() string {
+	if n, ok := testingOf(it.t).(namer); ok {
+		return n.Name()
+	}
+
+	return ""
+}
+
+// Logf formats its arguments like fmt.Sprintf and records the text in the test log.
+// It does nothing if the Testing doesn't implement Logf.
+func (it *Assertions) Logf(format string, args ...interface{}) {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if l, ok := testingOf(it.t).(logger); ok {
+		l.Logf(format, args...)
+	}
+}
+
+// Log formats its arguments like fmt.Sprintln and records the text in the test log.
+// It does nothing if the Testing doesn't implement Logf.
+func (it *Assertions) Log(args ...interface{}) {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if l, ok := testingOf(it.t).(logger); ok {
+		l.Logf("%s", strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+	}
+}
+
+// Skip marks the running test as skipped and stops its execution, or panic
+// if the Testing doesn't implement Skip.
+func (it *Assertions) Skip(args ...interface{}) {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	s, ok := testingOf(it.t).(skipper)
+	if !ok {
+		panic(fmt.Sprintf("test skipped and %T does not implement `Skip(...interface{})`", testingOf(it.t)))
+	}
+
+	s.Skip(args...)
+}
+
+// Cleanup registers a func to be called when the running test completes, or panic
+// if the Testing doesn't implement Cleanup.
+func (it *Assertions) Cleanup(fn func()) {
+	c, ok := testingOf(it.t).(cleaner)
+	if !ok {
+		panic(fmt.Sprintf("%T does not implement `Cleanup(func())`", testingOf(it.t)))
+	}
+
+	c.Cleanup(fn)
+}
+
 // IsType asserts that the v is of the same type.
 //
 //	it.IsType(int, 123)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) IsType(expectedType, v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return IsType(it.t, expectedType, v, formatAndArgs...)
 }
 
@@ -69,6 +169,10 @@ func (it *Assertions) IsType(expectedType, v interface{}, formatAndArgs ...inter
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Implements(iface, v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Implements(it.t, iface, v, formatAndArgs...)
 }
 
@@ -81,6 +185,10 @@ func (it *Assertions) Implements(iface, v interface{}, formatAndArgs ...interfac
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Contains(list, contains interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Contains(it.t, list, contains, formatAndArgs...)
 }
 
@@ -93,9 +201,221 @@ func (it *Assertions) Contains(list, contains interface{}, formatAndArgs ...inte
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotContains(list, contains interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotContains(it.t, list, contains, formatAndArgs...)
 }
 
+// ElementsMatch asserts that both collections have the same elements with the same counts in any order.
+//
+//	it.ElementsMatch([]int{1, 3, 2, 3}, []int{3, 3, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ElementsMatch(expected, actual interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return ElementsMatch(it.t, expected, actual, formatAndArgs...)
+}
+
+// NotElementsMatch asserts that both collections do NOT have the same elements with the same counts.
+//
+//	it.NotElementsMatch([]int{1, 2, 3}, []int{1, 2, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) NotElementsMatch(expected, actual interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return NotElementsMatch(it.t, expected, actual, formatAndArgs...)
+}
+
+// Subset asserts that every element of the subset is in the list, regardless of counts.
+//
+//	it.Subset([]string{"foo", "bar", "baz"}, []string{"baz", "foo"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Subset(list, subset interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Subset(it.t, list, subset, formatAndArgs...)
+}
+
+// NotSubset asserts that any element of the subset is NOT in the list.
+//
+//	it.NotSubset([]string{"foo", "bar"}, []string{"foo", "baz"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) NotSubset(list, subset interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return NotSubset(it.t, list, subset, formatAndArgs...)
+}
+
+// Disjoint asserts that both collections have no element in common.
+//
+//	it.Disjoint([]int{1, 2}, []int{3, 4})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Disjoint(a, b interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Disjoint(it.t, a, b, formatAndArgs...)
+}
+
+// Greater asserts that the v is greater than the than.
+//
+//	it.Greater(len(users), 0)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Greater(v, than interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Greater(it.t, v, than, formatAndArgs...)
+}
+
+// GreaterOrEqual asserts that the v is greater than or equal to the than.
+//
+//	it.GreaterOrEqual(user.Age, 18)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) GreaterOrEqual(v, than interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return GreaterOrEqual(it.t, v, than, formatAndArgs...)
+}
+
+// Less asserts that the v is less than the than.
+//
+//	it.Less(elapsed, time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Less(v, than interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Less(it.t, v, than, formatAndArgs...)
+}
+
+// LessOrEqual asserts that the v is less than or equal to the than.
+//
+//	it.LessOrEqual(len(page), 20)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) LessOrEqual(v, than interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return LessOrEqual(it.t, v, than, formatAndArgs...)
+}
+
+// Between asserts that the v is between lo and hi inclusively.
+//
+//	it.Between(user.Age, 18, 60)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Between(v, lo, hi interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Between(it.t, v, lo, hi, formatAndArgs...)
+}
+
+// Positive asserts that the number is greater than zero.
+//
+//	it.Positive(balance)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Positive(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Positive(it.t, v, formatAndArgs...)
+}
+
+// Negative asserts that the number is less than zero.
+//
+//	it.Negative(offset)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Negative(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Negative(it.t, v, formatAndArgs...)
+}
+
+// IsIncreasing asserts that elements of the list are strictly increasing.
+//
+//	it.IsIncreasing([]int{1, 2, 3})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) IsIncreasing(list interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return IsIncreasing(it.t, list, formatAndArgs...)
+}
+
+// IsDecreasing asserts that elements of the list are strictly decreasing.
+//
+//	it.IsDecreasing([]int{3, 2, 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) IsDecreasing(list interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return IsDecreasing(it.t, list, formatAndArgs...)
+}
+
+// IsNonDecreasing asserts that every element of the list is greater than or equal to the previous one.
+//
+//	it.IsNonDecreasing([]int{1, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) IsNonDecreasing(list interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return IsNonDecreasing(it.t, list, formatAndArgs...)
+}
+
+// IsNonIncreasing asserts that every element of the list is less than or equal to the previous one.
+//
+//	it.IsNonIncreasing([]int{2, 1, 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) IsNonIncreasing(list interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return IsNonIncreasing(it.t, list, formatAndArgs...)
+}
+
 // Match asserts that the regexp matches a string.
 //
 //	it.Match(regexp.MustCompile("start"), "it's starting")
@@ -103,6 +423,10 @@ func (it *Assertions) NotContains(list, contains interface{}, formatAndArgs ...i
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Match(reg, str interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Match(it.t, reg, str, formatAndArgs...)
 }
 
@@ -113,6 +437,10 @@ func (it *Assertions) Match(reg, str interface{}, formatAndArgs ...interface{})
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotMatch(reg, str interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotMatch(it.t, reg, str, formatAndArgs...)
 }
 
@@ -124,6 +452,14 @@ func (it *Assertions) NotMatch(reg, str interface{}, formatAndArgs ...interface{
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Equal(expected, actual interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(it.compare) > 0 {
+		return equalWith(it.t, expected, actual, it.compare, formatAndArgs...)
+	}
+
 	return Equal(it.t, expected, actual, formatAndArgs...)
 }
 
@@ -135,9 +471,43 @@ func (it *Assertions) Equal(expected, actual interface{}, formatAndArgs ...inter
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotEqual(expected, actual interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(it.compare) > 0 {
+		return notEqualWith(it.t, expected, actual, it.compare, formatAndArgs...)
+	}
+
 	return NotEqual(it.t, expected, actual, formatAndArgs...)
 }
 
+// EqualWith asserts that two objects are equal with options of the Assertions and the options.
+//
+//	it.EqualWith(expected, actual, assert.IgnoreFields("ID"))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) EqualWith(expected, actual interface{}, options ...CompareOption) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return equalWith(it.t, expected, actual, slices.Concat(it.compare, options))
+}
+
+// NotEqualWith asserts that two objects are NOT equal with options of the Assertions and the options.
+//
+//	it.NotEqualWith(expected, actual, assert.IgnoreFields("ID"))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) NotEqualWith(expected, actual interface{}, options ...CompareOption) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return notEqualWith(it.t, expected, actual, slices.Concat(it.compare, options))
+}
+
 // EqualValues asserts that two objects are equal
 // or convertable to the same types and equal.
 //
@@ -145,6 +515,10 @@ func (it *Assertions) NotEqual(expected, actual interface{}, formatAndArgs ...in
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) EqualValues(expected, actual interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return EqualValues(it.t, expected, actual, formatAndArgs...)
 }
 
@@ -154,14 +528,33 @@ func (it *Assertions) EqualValues(expected, actual interface{}, formatAndArgs ..
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Exactly(expected, actual interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Exactly(it.t, expected, actual, formatAndArgs...)
 }
 
 // Condition uses a custom Comparison to assert a complex condition.
 func (it *Assertions) Condition(comp Comparison, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Condition(it.t, comp, formatAndArgs...)
 }
 
+// That asserts that the actual matches the matcher, see That.
+//
+//	it.That(user, match.HasField("Name", match.Equals("golib")))
+func (it *Assertions) That(actual any, matcher Matcher, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return That(it.t, actual, matcher, formatAndArgs...)
+}
+
 // Empty asserts that the v is empty.  I.e. nil, "", false, 0,
 // or list(slice, map, channel) with len == 0.
 //
@@ -169,6 +562,10 @@ func (it *Assertions) Condition(comp Comparison, formatAndArgs ...interface{}) b
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Empty(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Empty(it.t, v, formatAndArgs...)
 }
 
@@ -181,6 +578,10 @@ func (it *Assertions) Empty(v interface{}, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotEmpty(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotEmpty(it.t, v, formatAndArgs...)
 }
 
@@ -190,6 +591,10 @@ func (it *Assertions) NotEmpty(v interface{}, formatAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) True(value bool, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return True(it.t, value, formatAndArgs...)
 }
 
@@ -199,6 +604,10 @@ func (it *Assertions) True(value bool, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) False(value bool, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return False(it.t, value, formatAndArgs...)
 }
 
@@ -206,6 +615,10 @@ func (it *Assertions) False(value bool, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Zero(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Zero(it.t, v, formatAndArgs...)
 }
 
@@ -213,6 +626,10 @@ func (it *Assertions) Zero(v interface{}, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotZero(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotZero(it.t, v, formatAndArgs...)
 }
 
@@ -223,6 +640,10 @@ func (it *Assertions) NotZero(v interface{}, formatAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Len(v interface{}, length int, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Len(it.t, v, length, formatAndArgs...)
 }
 
@@ -232,6 +653,10 @@ func (it *Assertions) Len(v interface{}, length int, formatAndArgs ...interface{
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Nil(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Nil(it.t, v, formatAndArgs...)
 }
 
@@ -241,6 +666,10 @@ func (it *Assertions) Nil(v interface{}, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotNil(v interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotNil(it.t, v, formatAndArgs...)
 }
 
@@ -253,6 +682,10 @@ func (it *Assertions) NotNil(v interface{}, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) IsError(err error, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return IsError(it.t, err, formatAndArgs...)
 }
 
@@ -265,6 +698,10 @@ func (it *Assertions) IsError(err error, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotError(err error, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotError(it.t, err, formatAndArgs...)
 }
 
@@ -275,6 +712,10 @@ func (it *Assertions) NotError(err error, formatAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) EqualError(err error, str string, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return EqualErrors(it.t, err, errors.New(str), formatAndArgs...)
 }
 
@@ -285,15 +726,89 @@ func (it *Assertions) EqualError(err error, str string, formatAndArgs ...interfa
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) EqualErrors(expectedErr, actualErr error, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return EqualErrors(it.t, actualErr, expectedErr, formatAndArgs...)
 }
 
+// ErrorAs asserts that at least one of errors in err's tree matches target.
+//
+//	var pathErr *fs.PathError
+//	it.ErrorAs(err, &pathErr)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ErrorAs(err error, target interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return ErrorAs(it.t, err, target, formatAndArgs...)
+}
+
+// ErrorContains asserts that err is not nil and its message contains the substr.
+//
+//	it.ErrorContains(err, "not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ErrorContains(err error, substr string, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return ErrorContains(it.t, err, substr, formatAndArgs...)
+}
+
+// ErrorMatches asserts that err is not nil and its message matches the regexp.
+//
+//	it.ErrorMatches(err, `^open .+: no such file`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ErrorMatches(err error, reg interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return ErrorMatches(it.t, err, reg, formatAndArgs...)
+}
+
+// ErrorChain asserts that the errors wrapped by err are exactly the chain.
+//
+//	it.ErrorChain(err, []error{ErrNotFound})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ErrorChain(err error, chain []error, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return ErrorChain(it.t, err, chain, formatAndArgs...)
+}
+
+// ErrorTree asserts that the errors wrapped by err are exactly the set, regardless of the order.
+//
+//	it.ErrorTree(err, []error{ErrPermission, ErrNotFound})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ErrorTree(err error, set []error, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return ErrorTree(it.t, err, set, formatAndArgs...)
+}
+
 // InDelta asserts that the two numerals are within delta of each other.
 //
 //	it.InDelta(math.Pi, (22 / 7.0), 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) InDelta(expected, actual interface{}, delta float64, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return InDelta(it.t, expected, actual, delta, formatAndArgs...)
 }
 
@@ -301,6 +816,10 @@ func (it *Assertions) InDelta(expected, actual interface{}, delta float64, forma
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) InDeltaSlice(expected, actual interface{}, delta float64, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return InDeltaSlice(it.t, expected, actual, delta, formatAndArgs...)
 }
 
@@ -310,27 +829,100 @@ func (it *Assertions) InDeltaSlice(expected, actual interface{}, delta float64,
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) WithinDuration(expected time.Time, actual time.Time, delta time.Duration, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return WithinDuration(it.t, expected, actual, delta, formatAndArgs...)
 }
 
-// ReaderContains asserts that io.Reader contains the specified sub string or element.
+// ReaderContains asserts that the reader contains the specified sub string or element.
 //
 //	reader := bytes.NewBuffer([]byte("Hello, world!"))
 //	it.ReaderContains(reader, "world")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (it *Assertions) ReaderContains(reader io.Reader, contains interface{}, formatAndArgs ...interface{}) bool {
-	return ReaderContains(it.t, reader, contains, formatAndArgs...)
+func (it *Assertions) ReaderContains(reader, contains interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerContains(it.t, reader, contains, it.limitOfReader(), formatAndArgs...)
 }
 
-// ReaderNotContains asserts that reader does NOT contain the specified substring or element.
+// ReaderNotContains asserts that the reader does NOT contain the specified substring or element.
 //
 //	reader := bytes.NewBuffer([]byte("Hello, world!"))
 //	it.ReaderNotContains(reader, "test")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (it *Assertions) ReaderNotContains(reader io.Reader, contains interface{}, formatAndArgs ...interface{}) bool {
-	return ReaderNotContains(it.t, reader, contains, formatAndArgs...)
+func (it *Assertions) ReaderNotContains(reader, contains interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerNotContains(it.t, reader, contains, it.limitOfReader(), formatAndArgs...)
+}
+
+// ReaderEqual asserts that data of the reader is equal to the expected string.
+//
+//	it.ReaderEqual(&resp.Body, "Hello, world!")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ReaderEqual(reader interface{}, expected string, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerEqual(it.t, reader, expected, it.limitOfReader(), formatAndArgs...)
+}
+
+// ReaderMatch asserts that data of the reader matches the regexp.
+//
+//	it.ReaderMatch(&resp.Body, `^Hello, \w+!$`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ReaderMatch(reader, reg interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerMatch(it.t, reader, reg, it.limitOfReader(), formatAndArgs...)
+}
+
+// ReaderJSON asserts that data of the reader is JSON equivalent to the expected.
+//
+//	it.ReaderJSON(&resp.Body, `{"hello": "world"}`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ReaderJSON(reader interface{}, expected string, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerJSON(it.t, reader, expected, it.limitOfReader(), formatAndArgs...)
+}
+
+// ReaderLen asserts that the reader has length bytes of data.
+//
+//	it.ReaderLen(&resp.Body, 13)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) ReaderLen(reader interface{}, length int, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerLen(it.t, reader, length, it.limitOfReader(), formatAndArgs...)
+}
+
+// limitOfReader returns limit of WithReaderLimit, or ReaderLimit if it is not set.
+func (it *Assertions) limitOfReader() int64 {
+	if it.readerLimit != nil {
+		return *it.readerLimit
+	}
+
+	return ReaderLimit
 }
 
 // Panics asserts that the code inside the specified PanicTestFunc panics.
@@ -341,6 +933,10 @@ func (it *Assertions) ReaderNotContains(reader io.Reader, contains interface{},
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) Panics(f PanicTestFunc, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return Panics(it.t, f, formatAndArgs...)
 }
 
@@ -352,6 +948,10 @@ func (it *Assertions) Panics(f PanicTestFunc, formatAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotPanics(f PanicTestFunc, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotPanics(it.t, f, formatAndArgs...)
 }
 
@@ -361,6 +961,10 @@ func (it *Assertions) NotPanics(f PanicTestFunc, formatAndArgs ...interface{}) b
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) EqualJSON(expected string, actual string, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return EqualJSON(it.t, expected, actual, formatAndArgs...)
 }
 
@@ -370,6 +974,10 @@ func (it *Assertions) EqualJSON(expected string, actual string, formatAndArgs ..
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) ContainsJSON(actual, key string, v interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return ContainsJSON(it.t, actual, key, v)
 }
 
@@ -379,6 +987,10 @@ func (it *Assertions) ContainsJSON(actual, key string, v interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotContainsJSON(actual, key string) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotContainsJSON(it.t, actual, key)
 }
 
@@ -388,5 +1000,50 @@ func (it *Assertions) NotContainsJSON(actual, key string) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (it *Assertions) NotEmptyJSON(actual, key string) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	return NotEmptyJSON(it.t, actual, key)
 }
+
+// JSONPathCount asserts that the JSONPath or JSON Pointer matches count value(s) of the actual.
+//
+//	it.JSONPathCount(`{"items": [{"id": 1}, {"id": 2}]}`, "$.items[*].id", 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) JSONPathCount(actual, path string, count int, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return JSONPathCount(it.t, actual, path, count, formatAndArgs...)
+}
+
+// JSONPathAll asserts that the JSONPath or JSON Pointer matches at least one value of the actual,
+// and all values matched are equal to value in JSON.
+//
+//	it.JSONPathAll(`{"users": [{"age": 31}, {"age": 42}]}`, "$.users[?(@.age>30)].age", 31)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) JSONPathAll(actual, path string, value interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return JSONPathAll(it.t, actual, path, value, formatAndArgs...)
+}
+
+// JSONPathAny asserts that any value of the actual matched by the JSONPath or JSON Pointer
+// is equal to value in JSON.
+//
+//	it.JSONPathAny(`{"items": [{"id": 1}, {"id": 2}]}`, "$..id", 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) JSONPathAny(actual, path string, value interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return JSONPathAny(it.t, actual, path, value, formatAndArgs...)
+}
@@ -2,6 +2,7 @@ package assert
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
 	"testing"
 	"time"
@@ -573,3 +574,59 @@ func TestJSONEqWrapper_ArraysOfDifferentOrder(t *testing.T) {
 		t.Error("JSONEq should return false")
 	}
 }
+
+func TestErrorChainWrappers(t *testing.T) {
+	it := New(new(testing.T))
+
+	errNotFound := errors.New("not found")
+	err := fmt.Errorf("load config: %w", errNotFound)
+
+	var target *customAsError
+	False(t, it.ErrorAs(err, &target))
+	True(t, it.ErrorContains(err, "not found"))
+	True(t, it.ErrorMatches(err, "^load config"))
+	True(t, it.ErrorChain(err, []error{errNotFound}))
+	True(t, it.ErrorTree(err, []error{errNotFound}))
+}
+
+func TestEqualWithEqualMethods(t *testing.T) {
+	now := time.Now()
+
+	it := New(t)
+	it.Equal(now, now.UTC())
+	it.Equal([]time.Time{now}, []time.Time{now.UTC()})
+
+	it = New(t, WithCompareOptions(IgnoreEqualMethods()))
+	it.NotEqual(now, now.UTC())
+}
+
+func TestTestingWrappers(t *testing.T) {
+	mockT := &mockTBTesting{mockNamedTesting: mockNamedTesting{name: "TestWrappers"}}
+	it := New(mockT)
+
+	Equal(t, "TestWrappers", it.Name())
+	Equal(t, "", New(&bufferT{}).Name())
+
+	it.Logf("hello, %s!", "world")
+	it.Log("hello", "world")
+	Equal(t, []string{"hello, world!", "hello world"}, mockT.logs)
+	NotPanics(t, func() {
+		New(&bufferT{}).Log("ignored")
+	})
+
+	it.Skip("skipped")
+	True(t, mockT.skipped)
+	Panics(t, func() {
+		New(&bufferT{}).Skip("skipped")
+	})
+
+	called := false
+	it.Cleanup(func() {
+		called = true
+	})
+	mockT.cleanup()
+	True(t, called)
+	Panics(t, func() {
+		New(&bufferT{}).Cleanup(func() {})
+	})
+}
@@ -1,13 +1,11 @@
 package assert
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"math"
 	"reflect"
-	"strconv"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,6 +19,10 @@ import (
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Nil(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if isNil(v) {
 		return true
 	}
@@ -34,6 +36,10 @@ func Nil(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotNil(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !isNil(v) {
 		return true
 	}
@@ -47,6 +53,10 @@ func NotNil(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Zero(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if v != nil && !reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface()) {
 		return Fail(t, pretty.Sprintf("Should be zero value of %T, but got: %#v", v, v), formatAndArgs...)
 	}
@@ -60,6 +70,10 @@ func Zero(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotZero(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if v == nil || reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface()) {
 		return Fail(t, pretty.Sprintf("Should NOT be zero value of %T, but got: %#v", v, v), formatAndArgs...)
 	}
@@ -73,6 +87,10 @@ func NotZero(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func True(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	var tv bool
 	switch t := v.(type) {
 	case bool:
@@ -92,6 +110,10 @@ func True(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func False(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	var fv bool
 	switch t := v.(type) {
 	case bool:
@@ -111,11 +133,15 @@ func False(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func IsType(t Testing, expectedType, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !AreEqualObjects(reflect.TypeOf(v), reflect.TypeOf(expectedType)) {
 		return Fail(t,
 			pretty.Sprintf(
 				"Expect type of values are NOT the same.%s",
-				diffValues(reflect.TypeOf(expectedType), reflect.TypeOf(v)),
+				diffValues(diffColorOf(t), reflect.TypeOf(expectedType), reflect.TypeOf(v)),
 			),
 			formatAndArgs...)
 	}
@@ -129,6 +155,10 @@ func IsType(t Testing, expectedType, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Implements(t Testing, iface, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	ifaceType := reflect.TypeOf(iface).Elem()
 
 	if !reflect.TypeOf(v).Implements(ifaceType) {
@@ -148,13 +178,12 @@ func Implements(t Testing, iface, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Equal(t Testing, expected, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !AreEqualObjects(expected, actual) {
-		return Fail(t,
-			pretty.Sprintf(
-				"Expected values are NOT equal.%s",
-				diffValues(expected, actual),
-			),
-			formatAndArgs...)
+		return failWithDiff(t, "Expected values are NOT equal.", expected, actual, formatAndArgs...)
 	}
 
 	return true
@@ -168,12 +197,16 @@ func Equal(t Testing, expected, actual any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotEqual(t Testing, expected, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if AreEqualObjects(expected, actual) {
 		expected, actual = prettifyValues(expected, actual)
 
 		return Fail(t, pretty.Sprintf(
 			"Expected values are NOT equal in value.%s",
-			diffValues(expected, actual),
+			diffValues(diffColorOf(t), expected, actual),
 		), formatAndArgs...)
 	}
 
@@ -186,13 +219,12 @@ func NotEqual(t Testing, expected, actual any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func EqualValues(t Testing, expected, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !AreEqualValues(expected, actual) {
-		return Fail(t,
-			pretty.Sprintf(
-				"Expected values are NOT equal in value.%s",
-				diffValues(expected, actual),
-			),
-			formatAndArgs...)
+		return failWithDiff(t, "Expected values are NOT equal in value.", expected, actual, formatAndArgs...)
 	}
 
 	return true
@@ -204,6 +236,10 @@ func EqualValues(t Testing, expected, actual any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Exactly(t Testing, expected, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	expectedType := reflect.TypeOf(expected)
 	actualType := reflect.TypeOf(actual)
 
@@ -211,7 +247,7 @@ func Exactly(t Testing, expected, actual any, formatAndArgs ...any) bool {
 		return Fail(t,
 			pretty.Sprintf(
 				"Expected values are NOT equal in type.%s",
-				diffValues(expectedType, actualType),
+				diffValues(diffColorOf(t), expectedType, actualType),
 			),
 			formatAndArgs...)
 	}
@@ -226,6 +262,10 @@ func Exactly(t Testing, expected, actual any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Empty(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if v == nil {
 		return true
 	}
@@ -248,6 +288,10 @@ func Empty(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotEmpty(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if v == nil || types.IsEmpty(v) {
 		return Fail(t,
 			pretty.Sprintf("Expected not to be empty, but got: %#v", v),
@@ -267,6 +311,10 @@ func NotEmpty(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Contains(t Testing, list, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	ok, found := containsElement(list, v)
 	if !ok {
 		return Fail(t,
@@ -293,6 +341,10 @@ func Contains(t Testing, list, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotContains(t Testing, list, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	ok, found := containsElement(list, v)
 	if !ok {
 		return Fail(t,
@@ -316,6 +368,10 @@ func NotContains(t Testing, list, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Match(t Testing, reg, str any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !tryMatch(reg, str) {
 		return Fail(t,
 			pretty.Sprintf("Expect string(%s) to match regexp(%s)", fmt.Sprint(str), fmt.Sprint(reg)),
@@ -332,6 +388,10 @@ func Match(t Testing, reg, str any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotMatch(t Testing, reg, str any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if tryMatch(reg, str) {
 		return Fail(t,
 			pretty.Sprintf("Expect string(%s) to NOT match regexp(%s)", fmt.Sprint(str), fmt.Sprint(reg)),
@@ -347,6 +407,10 @@ func NotMatch(t Testing, reg, str any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Condition(t Testing, comp Comparison, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !comp() {
 		return Fail(t, "Condition is failed!", formatAndArgs...)
 	}
@@ -361,6 +425,10 @@ func Condition(t Testing, comp Comparison, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Len(t Testing, v any, length int, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	n, ok := getLen(v)
 	if !ok {
 		return Fail(t,
@@ -386,6 +454,10 @@ func Len(t Testing, v any, length int, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func IsError(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if err, ok := v.(error); !ok || err == nil {
 		return Fail(t,
 			pretty.Sprintf("Expected value is an error, but got: %#v", v),
@@ -404,6 +476,10 @@ func IsError(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotError(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if v == nil {
 		return true
 	}
@@ -425,6 +501,10 @@ func NotError(t Testing, v any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func EqualErrors(t Testing, expected, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !IsError(t, expected, formatAndArgs...) {
 		return false
 	}
@@ -439,6 +519,205 @@ func EqualErrors(t Testing, expected, actual any, formatAndArgs ...any) bool {
 	return Equal(t, expected.(error), actual.(error), formatAndArgs...)
 }
 
+// ErrorAs asserts that at least one of errors in err's tree matches target,
+// and if so, sets target to that error value.
+//
+//	var pathErr *fs.PathError
+//	assert.ErrorAs(t, err, &pathErr)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorAs(t Testing, err error, target any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !isErrorTarget(target) {
+		return Fail(t,
+			pretty.Sprintf("Expected target is a non-nil pointer to either a type that implements error, or to any interface type, but got: %T", target),
+			formatAndArgs...)
+	}
+
+	if !errors.As(err, target) {
+		return failWithContent(t,
+			pretty.Sprintf("Expected error tree contains %v, but got: %#v", reflect.TypeOf(target).Elem(), err),
+			[]labeledContent{{"Tree", errorTree(err)}},
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorContains asserts that err is not nil and its message contains the substr.
+//
+//	assert.ErrorContains(t, err, "not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorContains(t Testing, err error, substr string, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		return Fail(t,
+			pretty.Sprintf("Expected an error containing %q, but got: nil", substr),
+			formatAndArgs...)
+	}
+
+	if !strings.Contains(err.Error(), substr) {
+		return failWithContent(t,
+			pretty.Sprintf("Expected error message contains %q, but got: %q", substr, err.Error()),
+			[]labeledContent{{"Tree", errorTree(err)}},
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorMatches asserts that err is not nil and its message matches the regexp.
+//
+//	assert.ErrorMatches(t, err, `^open .+: no such file`)
+//	assert.ErrorMatches(t, err, regexp.MustCompile(`timeout$`))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorMatches(t Testing, err error, reg any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		return Fail(t,
+			pretty.Sprintf("Expected an error matching regexp(%s), but got: nil", fmt.Sprint(reg)),
+			formatAndArgs...)
+	}
+
+	if !tryMatch(reg, err.Error()) {
+		return failWithContent(t,
+			pretty.Sprintf("Expect error message(%s) to match regexp(%s)", err.Error(), fmt.Sprint(reg)),
+			[]labeledContent{{"Tree", errorTree(err)}},
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorChain asserts that the errors wrapped by err are exactly the chain, in the order
+// of walking through Unwrap() error and Unwrap() []error depth first. A wrapped error
+// matches if it is the error of the chain, or reports it is by Is(error) bool. Messages
+// of errors are for failures only.
+//
+//	joined := errors.Join(ErrNotFound, ErrPermission)
+//	err := fmt.Errorf("load config: %w", joined)
+//	assert.ErrorChain(t, err, []error{joined, ErrNotFound, ErrPermission})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorChain(t Testing, err error, chain []error, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		return Fail(t,
+			pretty.Sprintf("Expected an error wrapping %d error(s), but got: nil", len(chain)),
+			formatAndArgs...)
+	}
+
+	var wrapped []error
+	walkErrors(err, func(e error, depth int) {
+		if depth > 0 {
+			wrapped = append(wrapped, e)
+		}
+	})
+
+	for i := 0; i < len(wrapped) || i < len(chain); i++ {
+		switch {
+		case i >= len(wrapped):
+			return failWithContent(t,
+				pretty.Sprintf("Expected wrapped error #%d is %s, but got: <none>", i, quoteError(chain[i])),
+				[]labeledContent{{"Tree", errorTree(err)}},
+				formatAndArgs...)
+
+		case i >= len(chain):
+			return failWithContent(t,
+				pretty.Sprintf("Expected %d wrapped error(s), but got unexpected error #%d: %s", len(chain), i, quoteError(wrapped[i])),
+				[]labeledContent{{"Tree", errorTree(err)}},
+				formatAndArgs...)
+
+		case !isSameError(wrapped[i], chain[i]):
+			return failWithContent(t,
+				pretty.Sprintf("Expected wrapped error #%d is %s, but got: %s", i, quoteError(chain[i]), quoteError(wrapped[i])),
+				[]labeledContent{{"Tree", errorTree(err)}},
+				formatAndArgs...)
+		}
+	}
+
+	return true
+}
+
+// ErrorTree asserts that the errors wrapped by err are exactly the set, regardless of the order.
+// An error of the set is found if any wrapped error is it, or reports it is by Is(error) bool.
+// A wrapped error is unexpected unless it or any error it wraps is of the set, so that errors
+// joining the set, i.e. errors.Join, are not necessary in the set. Messages of errors are for
+// failures only.
+//
+//	err := fmt.Errorf("load config: %w", errors.Join(ErrNotFound, ErrPermission))
+//	assert.ErrorTree(t, err, []error{ErrPermission, ErrNotFound})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorTree(t Testing, err error, set []error, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		return Fail(t,
+			pretty.Sprintf("Expected an error wrapping %d error(s), but got: nil", len(set)),
+			formatAndArgs...)
+	}
+
+	var wrapped []error
+	walkErrors(err, func(e error, depth int) {
+		if depth > 0 {
+			wrapped = append(wrapped, e)
+		}
+	})
+
+	var missing []string
+	for _, target := range set {
+		if !slices.ContainsFunc(wrapped, func(e error) bool { return isSameError(e, target) }) {
+			missing = append(missing, quoteError(target))
+		}
+	}
+
+	var unexpected []string
+	for _, e := range wrapped {
+		if !slices.ContainsFunc(set, func(target error) bool { return target != nil && errors.Is(e, target) }) {
+			unexpected = append(unexpected, quoteError(e))
+		}
+	}
+
+	switch {
+	case len(missing) > 0 && len(unexpected) > 0:
+		return failWithContent(t,
+			pretty.Sprintf("Expected error tree contains %s, but got unexpected %s", strings.Join(missing, ", "), strings.Join(unexpected, ", ")),
+			[]labeledContent{{"Tree", errorTree(err)}},
+			formatAndArgs...)
+
+	case len(missing) > 0:
+		return failWithContent(t,
+			pretty.Sprintf("Expected error tree contains %s", strings.Join(missing, ", ")),
+			[]labeledContent{{"Tree", errorTree(err)}},
+			formatAndArgs...)
+
+	case len(unexpected) > 0:
+		return failWithContent(t,
+			pretty.Sprintf("Expected error tree of %d error(s), but got unexpected %s", len(set), strings.Join(unexpected, ", ")),
+			[]labeledContent{{"Tree", errorTree(err)}},
+			formatAndArgs...)
+	}
+
+	return true
+}
+
 // Panics asserts that the code inside the specified PanicTestFunc panics.
 //
 //	assert.Panics(t, func(){
@@ -447,6 +726,10 @@ func EqualErrors(t Testing, expected, actual any, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Panics(t Testing, f PanicTestFunc, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if isRecovered, _ := panicRecovery(f); !isRecovered {
 		return Fail(t,
 			pretty.Sprintf("Expected Func(%T) should panic.", f),
@@ -464,6 +747,10 @@ func Panics(t Testing, f PanicTestFunc, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotPanics(t Testing, f PanicTestFunc, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if isRecovered, panicValue := panicRecovery(f); isRecovered {
 		return Fail(t,
 			pretty.Sprintf("Expected Func(%T) should not panic, but paniced with: %v", f, panicValue),
@@ -479,6 +766,10 @@ func NotPanics(t Testing, f PanicTestFunc, formatAndArgs ...any) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func WithinDuration(t Testing, expected, actual time.Time, delta time.Duration, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if dt := expected.Sub(actual); dt < -delta || dt > delta {
 		return Fail(t,
 			pretty.Sprintf("Expected max difference between %v and %v allowed is %v, but got: %v", expected, actual, delta, dt),
@@ -494,6 +785,10 @@ func WithinDuration(t Testing, expected, actual time.Time, delta time.Duration,
 //
 // Returns whether the assertion was successful (true) or not (false).
 func InDelta(t Testing, expected, actual any, delta float64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	af, aok := toFloat(expected)
 	bf, bok := toFloat(actual)
 
@@ -526,6 +821,10 @@ func InDelta(t Testing, expected, actual any, delta float64, formatAndArgs ...an
 
 // InDeltaSlice is the same as InDelta, except it compares two slices.
 func InDeltaSlice(t Testing, expected, actual any, delta float64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if expected == nil || actual == nil ||
 		reflect.TypeOf(actual).Kind() != reflect.Slice ||
 		reflect.TypeOf(expected).Kind() != reflect.Slice {
@@ -545,250 +844,203 @@ func InDeltaSlice(t Testing, expected, actual any, delta float64, formatAndArgs
 	return true
 }
 
-// ReaderContains asserts that the specified io.Reader contains the specified sub string or element.
+// EqualJSON asserts that two JSON strings are equivalent, numbers are compared by value.
+// It reports every mismatch with JSON Pointer of it, see EqualJSONWith for options.
 //
-//	assert.ReaderContains(t, http.Response.Body, "Earth", "But 'http.Response.Body' does NOT contain 'Earth'")
+//	assert.EqualJSON(t, `{"hello": "world", "foo": "bar"}`, `{"foo": "bar", "hello": "world"}`)
 //
 // Returns whether the assertion was successful (true) or not (false).
-// NOTE: It will introduce side effects on reader, use it with caution!
-func ReaderContains(t Testing, reader io.Reader, contains any, formatAndArgs ...any) bool {
-	w, ok := reader.(io.Writer)
-	if !ok {
-		return Fail(t, "Reader must implement io.Writer", formatAndArgs...)
-	}
-
-	data, err := io.ReadAll(reader)
-	if err != nil {
-		return Fail(t,
-			pretty.Sprintf("IsError read from \"%T\" of \"%s\"", reader, err.Error()),
-			formatAndArgs...)
+func EqualJSON(t Testing, expected, actual string, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
 	}
 
-	// try to reset reader
-	_, _ = w.Write(data)
-
-	return Contains(t, string(data), contains, formatAndArgs...)
+	return equalJSON(t, expected, actual, jsonOptions{numbersByValue: true}, formatAndArgs...)
 }
 
-// ReaderNotContains asserts that the specified io.Reader does not contain the specified substring or element.
+// ContainsJSON asserts that the js string contains JSON value of the key.
+// The key is dotted keys with numeric subscripts, JSON Pointer or JSONPath matching exactly one value,
+// a key starting with "/" is looked up as dotted keys if it resolves nothing as JSON Pointer.
+// The JSON value is decoded into type of the value with encoding/json and compared by AreEqualObjects,
+// and nil value matches JSON null only.
 //
-//	assert.ReaderNotContains(t, http.Response.Body, "Earth", "But 'http.Response.Body' does NOT contain 'Earth'")
+//	assert.ContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "hello", "world")
+//	assert.ContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "foo.1", "bar")
+//	assert.ContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "/foo/1", "bar")
+//	assert.ContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "$.foo[-1]", "bar")
+//	assert.ContainsJSON(t, `{"hello": "world", "foo": null}`, "foo", nil)
 //
 // Returns whether the assertion was successful (true) or not (false).
-// NOTE: It will introduce side effects on reader, use it with caution!
-func ReaderNotContains(t Testing, reader io.Reader, contains any, formatAndArgs ...any) bool {
-	w, ok := reader.(io.Writer)
-	if !ok {
-		return Fail(t, "Reader must implement io.Writer", formatAndArgs...)
+func ContainsJSON(t Testing, actual, key string, value any, formatArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return containsJSON(t, actual, key, reflect.TypeOf(value), value, formatArgs...)
+}
+
+func containsJSON(t Testing, actual, key string, typ reflect.Type, value any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
 	}
 
-	data, err := io.ReadAll(reader)
+	data, err := getJsonRawValue(actual, key)
 	if err != nil {
 		return Fail(t,
-			pretty.Sprintf("IsError read from \"%T\" of \"%s\"", reader, err.Error()),
+			pretty.Sprintf("Expected contains actual key %s of value %s, but got: %+v", key, value, err),
 			formatAndArgs...)
 	}
 
-	// try to reset reader
-	_, _ = w.Write(data)
+	if message := matchJsonValue(diffColorOf(t), key, data, typ, value); message != "" {
+		return Fail(t, message, formatAndArgs...)
+	}
 
-	return NotContains(t, string(data), contains, formatAndArgs...)
+	return true
 }
 
-// EqualJSON asserts that two JSON strings are equivalent.
+// NotContainsJSON asserts that the actual does not contain JSON key.
 //
-//	assert.EqualJSON(t, `{"hello": "world", "foo": "bar"}`, `{"foo": "bar", "hello": "world"}`)
+//	assert.NotContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "world")
+//	assert.NotContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "foo.3")
+//	assert.NotContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "$.foo[3]")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func EqualJSON(t Testing, expected, actual string, formatAndArgs ...any) bool {
-	var expectedJSONAsInterface, actualJSONAsInterface any
-
-	if err := json.Unmarshal([]byte(expected), &expectedJSONAsInterface); err != nil {
-		return Fail(t,
-			pretty.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()),
-			formatAndArgs...)
+func NotContainsJSON(t Testing, actual, key string, formatArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
 	}
 
-	if err := json.Unmarshal([]byte(actual), &actualJSONAsInterface); err != nil {
+	if data, err := getJsonValue(actual, key); err == nil {
 		return Fail(t,
-			pretty.Sprintf("Input ('%s') needs to be valid json.\nJSON parsing error: '%s'", actual, err.Error()),
-			formatAndArgs...)
+			pretty.Sprintf("Expected does not contain json key %q, but got: %s", key, data),
+			formatArgs...)
 	}
 
-	return Equal(t, expectedJSONAsInterface, actualJSONAsInterface, formatAndArgs...)
+	return true
 }
 
-// ContainsJSON asserts that the js string contains JSON value of the key.
+// NotEmptyJSON asserts that the actual contains JSON key, and the value is not empty.
 //
-//	assert.ContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "hello", "world")
-//	assert.ContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "foo.1", "bar")
+//	assert.NotEmptyJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "world")
+//	assert.NotEmptyJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "foo.3")
+//	assert.NotEmptyJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "/foo/0")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func ContainsJSON(t Testing, actual, key string, value any, formatArgs ...any) bool {
+func NotEmptyJSON(t Testing, actual, key string, formatArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	data, err := getJsonValue(actual, key)
 	if err != nil {
 		return Fail(t,
-			pretty.Sprintf("Expected contains actual key %s of value %s, but got: %+v", key, value, err),
+			pretty.Sprintf("Failed to get json value of key %q: %v", key, err),
+			formatArgs...)
+	}
+	if len(data) == 0 {
+		return Fail(t,
+			pretty.Sprintf("Expected contains json key %q, but got: <empty>", key),
 			formatArgs...)
 	}
 
-	keyValue := string(data)
-
-	switch expected := value.(type) {
-	case []byte:
-		return EqualValues(t, expected, data,
-			"Expected contains actual key %q of byte: %s, but got: %s", key, expected, data)
-
-	case string:
-		return EqualValues(t, expected, keyValue,
-			"Expected contains actual key %q of string: %s, but got: %s", key, expected, data)
-
-	case int8:
-		actualValue, _ := strconv.Atoi(keyValue)
-
-		return EqualValues(t, expected, int8(actualValue),
-			"Expected contains actual key %q of int8: %v, but got: %s", key, expected, data)
-
-	case int:
-		actualValue, _ := strconv.Atoi(keyValue)
-
-		return EqualValues(t, expected, int(actualValue),
-			"Expected contains actual key %q of int: %v, but got: %s", key, expected, data)
-
-	case int16:
-		actualValue, _ := strconv.ParseInt(keyValue, 10, 16)
-
-		return EqualValues(t, expected, int16(actualValue),
-			"Expected contains actual key %q of int16: %v, but got %s", key, expected, data)
-
-	case int32:
-		actualValue, _ := strconv.ParseInt(keyValue, 10, 32)
-
-		return EqualValues(t, expected, int32(actualValue),
-			"Expected contains actual key %q of int32: %v, but got: %s", key, expected, data)
-
-	case int64:
-		actualValue, _ := strconv.ParseInt(keyValue, 10, 64)
-
-		return EqualValues(t, expected, actualValue,
-			"Expected contains actual key %q of int64: %v, but got: %s", key, expected, data)
-
-	case float32:
-		actualValue, _ := strconv.ParseFloat(keyValue, 32)
-
-		return EqualValues(t, expected, float32(actualValue),
-			"Expected contains actual key %q of float32: %v, but got: %v", key, expected, data)
-
-	case float64:
-		actualValue, _ := strconv.ParseFloat(keyValue, 64)
+	return true
+}
 
-		return EqualValues(t, expected, actualValue,
-			"Expected contains actual key %q of float64: %v, but got: %v", key, expected, data)
+// JSONPathCount asserts that the JSONPath or JSON Pointer matches count value(s) of the actual.
+//
+//	assert.JSONPathCount(t, `{"items": [{"id": 1}, {"id": 2}]}`, "$.items[*].id", 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func JSONPathCount(t Testing, actual, path string, count int, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
-	case bool:
-		switch strings.ToLower(keyValue) {
-		case "true", "1", "on", "yes":
-			return True(t, expected,
-				"Expected contains actual key %q of [true|1|on], but got: %s", data)
-
-		default:
-			return False(t, expected,
-				"Expected contains actual key %q of [false|0|off], but got: %s", data)
+	nodes, err := queryJSON(actual, path)
+	if err != nil {
+		var notFound *errJSONPathNotFound
+		if !errors.As(err, &notFound) {
+			return Fail(t,
+				pretty.Sprintf("Failed to query json path %q: %v", path, err),
+				formatAndArgs...)
 		}
 
-	default:
-		expectType := reflect.TypeOf(value)
-		switch expectType.Kind() {
-		case reflect.Ptr:
-			if !isJsonEqualObject(keyValue, value) {
-				return Fail(t,
-					pretty.Sprintf("Expected contains actual key %s of value %s, but got: %s", key, value, keyValue),
-					formatArgs...)
-			}
-
-		case reflect.Array:
-			fallthrough
-		case reflect.Slice:
-			// first, try with reflect
-			actualValue := reflect.New(expectType)
-			if err := json.Unmarshal(data, actualValue.Interface()); err == nil {
-				expectedValue := reflect.ValueOf(expected)
-				return EqualValues(t, expectedValue.Interface(), actualValue.Elem().Interface(),
-					"Expected contains actual key %q of slice: %+v, but got: %+v", key, expectedValue.Interface(), actualValue.Elem().Interface())
-			}
-
-			// second, try with json string
-			if !isJsonEqualObject(keyValue, value) {
-				return Fail(t,
-					pretty.Sprintf("Expected contains actual key %s of value %s, but got: %s", key, value, keyValue),
-					formatArgs...)
-			}
-
-		case reflect.Struct:
-			// first, try with reflect
-			actualValue := reflect.New(expectType)
-			if err := json.Unmarshal(data, actualValue.Interface()); err == nil {
-				expectedValue := reflect.ValueOf(expected)
-				return EqualValues(t, expectedValue.Interface(), actualValue.Elem().Interface(),
-					"Expected contains actual key %q of slice: %+v, but got: %+v", key, expectedValue.Interface(), actualValue.Elem().Interface())
-			}
-
-			// second, try with json string
-			if !isJsonEqualObject(keyValue, value) {
-				return Fail(t,
-					pretty.Sprintf("Expected contains actual key %s of value %s, but got: %s", key, value, keyValue),
-					formatArgs...)
-			}
-
-		case reflect.Func:
-			if !isJsonEqualObject(keyValue, value) {
-				return Fail(t,
-					pretty.Sprintf("Expected contains actual key %s of value %s, but got: %s", key, value, keyValue),
-					formatArgs...)
-			}
-
+		if count != 0 {
+			return Fail(t,
+				pretty.Sprintf("Expected json path %q to match %d value(s), but got: %v", path, count, err),
+				formatAndArgs...)
 		}
 	}
 
-	return Fail(t,
-		pretty.Sprintf("Expected contains actual key %s of value %s, but got: %s", key, value, keyValue),
-		formatArgs...)
+	if len(nodes) != count {
+		return Fail(t,
+			pretty.Sprintf("Expected json path %q to match %d value(s), but got %d:\n%s", path, count, len(nodes), formatJSONNodes(nodes)),
+			formatAndArgs...)
+	}
+
+	return true
 }
 
-// NotContainsJSON asserts that the actual does not contain JSON key.
+// JSONPathAll asserts that the JSONPath or JSON Pointer matches at least one value of the actual,
+// and all values matched are equal to value in JSON.
 //
-//	assert.NotContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "world")
-//	assert.NotContainsJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "foo.3")
+//	assert.JSONPathAll(t, `{"users": [{"age": 31}, {"age": 42}]}`, "$.users[?(@.age>30)].age", 31)
 //
 // Returns whether the assertion was successful (true) or not (false).
-func NotContainsJSON(t Testing, actual, key string, formatArgs ...any) bool {
-	if data, err := getJsonValue(actual, key); err == nil {
+func JSONPathAll(t Testing, actual, path string, value any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	nodes, err := queryJSON(actual, path)
+	if err != nil {
 		return Fail(t,
-			pretty.Sprintf("Expected does not contain json key %q, but got: %s", key, data),
-			formatArgs...)
+			pretty.Sprintf("Failed to query json path %q: %v", path, err),
+			formatAndArgs...)
+	}
+
+	var mismatches []jsonNode
+	for _, node := range nodes {
+		if !isJSONNodeEqual(node.value, value) {
+			mismatches = append(mismatches, node)
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return Fail(t,
+			pretty.Sprintf("Expected all values of json path %q to be %# v, but %d of %d are not:\n%s",
+				path, value, len(mismatches), len(nodes), formatJSONNodes(mismatches)),
+			formatAndArgs...)
 	}
 
 	return true
 }
 
-// NotEmptyJSON asserts that the actual contains JSON key, and the value is not empty.
+// JSONPathAny asserts that any value of the actual matched by the JSONPath or JSON Pointer
+// is equal to value in JSON.
 //
-//	assert.NotEmptyJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "world")
-//	assert.NotEmptyJSON(t, `{"hello": "world", "foo": ["foo", "bar"]}`, "foo.3")
+//	assert.JSONPathAny(t, `{"items": [{"id": 1}, {"id": 2}]}`, "$..id", 2)
 //
 // Returns whether the assertion was successful (true) or not (false).
-func NotEmptyJSON(t Testing, actual, key string, formatArgs ...any) bool {
-	data, err := getJsonValue(actual, key)
+func JSONPathAny(t Testing, actual, path string, value any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	nodes, err := queryJSON(actual, path)
 	if err != nil {
 		return Fail(t,
-			pretty.Sprintf("Failed to get json value of key %q: %v", key, err),
-			formatArgs...)
+			pretty.Sprintf("Failed to query json path %q: %v", path, err),
+			formatAndArgs...)
 	}
-	if len(data) == 0 {
-		return Fail(t,
-			pretty.Sprintf("Expected contains json key %q, but got: <empty>", key),
-			formatArgs...)
+
+	for _, node := range nodes {
+		if isJSONNodeEqual(node.value, value) {
+			return true
+		}
 	}
 
-	return true
+	return Fail(t,
+		pretty.Sprintf("Expected any value of json path %q to be %# v, but got:\n%s", path, value, formatJSONNodes(nodes)),
+		formatAndArgs...)
 }
@@ -2,10 +2,12 @@ package assert
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"runtime"
@@ -140,6 +142,34 @@ func (t *bufferT) Errorf(format string, args ...interface{}) {
 	t.buf.WriteString(decorate(fmt.Sprintf(format, args...)))
 }
 
+// helperT is the bufferT which attributes failures to the first caller not marked by Helper, as testing.T does.
+type helperT struct {
+	bufferT
+
+	helpers map[string]bool
+}
+
+func (t *helperT) Helper() {
+	pc, _, _, _ := runtime.Caller(1)
+
+	if t.helpers == nil {
+		t.helpers = make(map[string]bool)
+	}
+	t.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+func (t *helperT) Errorf(format string, args ...interface{}) {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !t.helpers[frame.Function] || !more {
+			fmt.Fprintf(&t.buf, "\t%s:%d: %s\n", filepath.Base(frame.File), frame.Line, fmt.Sprintf(format, args...))
+			return
+		}
+	}
+}
+
 func Test_Nil(t *testing.T) {
 	mockT := new(testing.T)
 
@@ -495,14 +525,17 @@ func Test_NotEqual(t *testing.T) {
 }
 
 func Test_EqualFormatting(t *testing.T) {
+	SetColor(ColorAlways)
+	defer SetColor(ColorAuto)
+
 	for i, currCase := range []struct {
 		equalWant     string
 		equalGot      string
 		formatAndArgs []interface{}
 		want          string
 	}{
-		{equalWant: "want", equalGot: "got", want: "\tasserts.go:152: \r                        \r\tTrace:\t\n\t\t\r\tError:\tExpected values are NOT equal.\n\t\t\r\t      \t\n\t\t\r\t      \t\x1b[0;31m--- Expected\x1b[0m\n\t\t\r\t      \t\x1b[0;34m+++ Actual\x1b[0m\n\t\t\r\t      \t\x1b[0;38m@@ -1 +1 @@\x1b[0m\n\t\t\r\t      \t\x1b[0;31m-\"want\"\x1b[0m\n\t\t\r\t      \t\x1b[0;34m+\"got\"\x1b[0m\n\t\t\r\t      \t\x1b[0;38m\x1b[0m\n\t\t\n"},
-		{equalWant: "want", equalGot: "got", formatAndArgs: []interface{}{"hello, %v!", "world"}, want: "\tasserts.go:152: \r                        \r\tTrace:   \t\n\t\t\r\tError:   \tExpected values are NOT equal.\n\t\t\r\t         \t\n\t\t\r\t         \t\x1b[0;31m--- Expected\x1b[0m\n\t\t\r\t         \t\x1b[0;34m+++ Actual\x1b[0m\n\t\t\r\t         \t\x1b[0;38m@@ -1 +1 @@\x1b[0m\n\t\t\r\t         \t\x1b[0;31m-\"want\"\x1b[0m\n\t\t\r\t         \t\x1b[0;34m+\"got\"\x1b[0m\n\t\t\r\t         \t\x1b[0;38m\x1b[0m\n\t\t\r\tMessages:\thello, world!\n\t\t\n"},
+		{equalWant: "want", equalGot: "got", want: "\r                        \r\t\x1b[0;33mTrace:\x1b[0m\t\n\t\t\r\t\x1b[0;33mError:\x1b[0m\tExpected values are NOT equal.\n\t\t\r\t      \t\n\t\t\r\t      \t\x1b[0;31m--- Expected\x1b[0m\n\t\t\r\t      \t\x1b[0;34m+++ Actual\x1b[0m\n\t\t\r\t      \t\x1b[0;38m@@ -1 +1 @@\x1b[0m\n\t\t\r\t      \t\x1b[0;31m-\"want\"\x1b[0m\n\t\t\r\t      \t\x1b[0;34m+\"got\"\x1b[0m\n\t\t\r\t      \t\x1b[0;38m\x1b[0m\n\t\t\n"},
+		{equalWant: "want", equalGot: "got", formatAndArgs: []interface{}{"hello, %v!", "world"}, want: "\r                        \r\t\x1b[0;33mTrace:\x1b[0m   \t\n\t\t\r\t\x1b[0;33mError:\x1b[0m   \tExpected values are NOT equal.\n\t\t\r\t         \t\n\t\t\r\t         \t\x1b[0;31m--- Expected\x1b[0m\n\t\t\r\t         \t\x1b[0;34m+++ Actual\x1b[0m\n\t\t\r\t         \t\x1b[0;38m@@ -1 +1 @@\x1b[0m\n\t\t\r\t         \t\x1b[0;31m-\"want\"\x1b[0m\n\t\t\r\t         \t\x1b[0;34m+\"got\"\x1b[0m\n\t\t\r\t         \t\x1b[0;38m\x1b[0m\n\t\t\r\t\x1b[0;33mMessages:\x1b[0m\thello, world!\n\t\t\n"},
 	} {
 		mockT := &bufferT{}
 		Equal(mockT, currCase.equalWant, currCase.equalGot, currCase.formatAndArgs...)
@@ -510,6 +543,21 @@ func Test_EqualFormatting(t *testing.T) {
 			t.Errorf("Equal (%d) output is different!\nGot = %#v\nWant = %#v", i, mockT.buf.String(), currCase.want)
 		}
 	}
+
+	// failures are attributed to the caller of assertion by Testing with Helper, through any Reporter
+	mockT := &helperT{}
+
+	_, file, line, _ := runtime.Caller(0)
+	Equal(mockT, "want", "got")
+	True(t, strings.HasPrefix(mockT.buf.String(), fmt.Sprintf("\t%s:%d: ", filepath.Base(file), line+1)), mockT.buf.String())
+
+	for _, reporter := range []Reporter{TextReporter{}, NewJSONReporter(nil)} {
+		mockT := &helperT{}
+
+		_, file, line, _ := runtime.Caller(0)
+		New(mockT, WithReporter(reporter)).Equal("want", "got")
+		True(t, strings.HasPrefix(mockT.buf.String(), fmt.Sprintf("\t%s:%d: ", filepath.Base(file), line+1)), mockT.buf.String())
+	}
 }
 
 func Test_EqualValues(t *testing.T) {
@@ -1221,9 +1269,12 @@ func TestEqualJSON_ArraysOfDifferentOrder(t *testing.T) {
 }
 
 func TestDiff(t *testing.T) {
+	policy := colorPolicy{enabled: true, palette: DefaultPalette()}
+
 	expected := "\n\n\x1b[0;31m--- Expected\x1b[0m\n\x1b[0;34m+++ Actual\x1b[0m\n\x1b[0;38m@@ -1 +1 @@\x1b[0m\n\x1b[0;31m-struct { foo string }{foo:\"hello\"}\x1b[0m\n\x1b[0;34m+struct { foo string }{foo:\"bar\"}\x1b[0m\n\x1b[0;38m\x1b[0m\n"
 
 	actual := diffValues(
+		policy,
 		struct{ foo string }{"hello"},
 		struct{ foo string }{"bar"},
 	)
@@ -1232,6 +1283,7 @@ func TestDiff(t *testing.T) {
 	expected = "\n\n\x1b[0;31m--- Expected\x1b[0m\n\x1b[0;34m+++ Actual\x1b[0m\n\x1b[0;38m@@ -1 +1 @@\x1b[0m\n\x1b[0;31m-[]int{1, 2, 3, 4}\x1b[0m\n\x1b[0;34m+[]int{1, 3, 5, 7}\x1b[0m\n\x1b[0;38m\x1b[0m\n"
 
 	actual = diffValues(
+		policy,
 		[]int{1, 2, 3, 4},
 		[]int{1, 3, 5, 7},
 	)
@@ -1240,6 +1292,7 @@ func TestDiff(t *testing.T) {
 	expected = "\n\n\x1b[0;31m--- Expected\x1b[0m\n\x1b[0;34m+++ Actual\x1b[0m\n\x1b[0;38m@@ -1 +1 @@\x1b[0m\n\x1b[0;31m-[]int{1, 2, 3}\x1b[0m\n\x1b[0;34m+[]int{1, 3, 5}\x1b[0m\n\x1b[0;38m\x1b[0m\n"
 
 	actual = diffValues(
+		policy,
 		[]int{1, 2, 3, 4}[0:3],
 		[]int{1, 3, 5, 7}[0:3],
 	)
@@ -1265,8 +1318,8 @@ func TestDiff(t *testing.T) {
 }
 
 func TestDiffEmptyCases(t *testing.T) {
-	Equal(t, "", diffValues(nil, nil))
-	Equal(t, "", diffValues("", ""))
+	Equal(t, "", diffValues(colorPolicy{}, nil, nil))
+	Equal(t, "", diffValues(colorPolicy{}, "", ""))
 }
 
 // Ensure there are no data races
@@ -1292,7 +1345,7 @@ func TestDiffRace(t *testing.T) {
 		rChans[idx] = make(chan string)
 		go func(ch chan string) {
 			defer close(ch)
-			ch <- diffValues(expected, actual)
+			ch <- diffValues(colorPolicy{}, expected, actual)
 		}(rChans[idx])
 	}
 
@@ -1331,40 +1384,6 @@ func TestFailNowWithFullTesting(t *testing.T) {
 	}, "should call mockT.FailNow() rather than panicking")
 }
 
-func TestReaderContains(t *testing.T) {
-	mockT := new(testing.T)
-
-	// for reader
-	reader := strings.NewReader("Hello, World")
-
-	if ReaderContains(mockT, reader, "Hello") {
-		t.Error("Contains should return false, strings.Reader must implement io.Reader")
-	}
-
-	// for reader writer
-	readWriter := bytes.NewBufferString("Hello, World")
-	if !ReaderContains(mockT, readWriter, "Hello") {
-		t.Error("Contains should return true")
-	}
-}
-
-func TestReaderNotContains(t *testing.T) {
-	mockT := new(testing.T)
-
-	// for reader
-	reader := strings.NewReader("Hello, World")
-
-	if ReaderNotContains(mockT, reader, "Hello") {
-		t.Error("NotContains should return true, strings.Reader must implement io.Reader")
-	}
-
-	// for reader writer
-	readWriter := bytes.NewBufferString("Hello, World")
-	if ReaderNotContains(mockT, readWriter, "Hello") {
-		t.Error("NotContains should return false")
-	}
-}
-
 func TestContainsJSON(t *testing.T) {
 	mockT := new(testing.T)
 
@@ -1405,6 +1424,52 @@ func TestContainsJSON(t *testing.T) {
 	}
 }
 
+func TestContainsJSONTyped(t *testing.T) {
+	mockT := new(testing.T)
+
+	jsonStr := `{"u8": 255, "u64": 18446744073709551615, "big": 300, "f": 1.5, "flag": "yes", "none": null, "s": "a\"b", "m": {"a": 1}, "n": 12.50}`
+
+	True(t, ContainsJSON(mockT, jsonStr, "u8", uint8(255)))
+	True(t, ContainsJSON(mockT, jsonStr, "u64", uint64(18446744073709551615)))
+	True(t, ContainsJSON(mockT, jsonStr, "f", float32(1.5)))
+	True(t, ContainsJSON(mockT, jsonStr, "n", json.Number("12.50")))
+	True(t, ContainsJSON(mockT, jsonStr, "s", `a"b`))
+	True(t, ContainsJSON(mockT, jsonStr, "m", map[string]int{"a": 1}))
+	True(t, ContainsJSON(mockT, jsonStr, "m", json.RawMessage(`{"a": 1.0}`)))
+	True(t, ContainsJSON(mockT, jsonStr, "m", map[string]any{"a": 1}))
+	True(t, ContainsJSON(mockT, `{"list": [1, "two", {"n": 3}]}`, "list", []any{1, "two", map[string]int{"n": 3}}))
+	True(t, ContainsJSONOf[any](mockT, jsonStr, "u8", 255))
+	True(t, ContainsJSONOf(mockT, jsonStr, "m", struct {
+		A any `json:"a"`
+	}{A: uint8(1)}))
+	True(t, ContainsJSON(mockT, jsonStr, "none", nil))
+	True(t, ContainsJSON(mockT, jsonStr, "none", (*int)(nil)))
+	True(t, ContainsJSON(mockT, jsonStr, "/u8", uint8(255)))
+
+	age := 255
+	True(t, ContainsJSON(mockT, jsonStr, "u8", &age))
+
+	for _, tc := range []struct {
+		key   string
+		value any
+		want  string
+	}{
+		{"flag", true, "key flag is a string, expected boolean"},
+		{"u8", "255", "key u8 is a number, expected string"},
+		{"none", 0, "key none is null, expected number"},
+		{"s", nil, "key s is a string, expected null"},
+		{"m", []int{1}, "key m is an object, expected array"},
+		{"big", int8(1), "cannot be decoded into int8"},
+		{"u8", uint8(1), "Expected contains actual key u8 of value 1, but got: 255"},
+		{"m", map[string]any{"a": 2}, "Expected key m of json {\"a\":2}, but got: {\"a\": 1}"},
+		{"m", map[string]any{"a": 1, "b": 2}, "Expected key m of json"},
+	} {
+		bufT := &bufferT{}
+		False(t, ContainsJSON(bufT, jsonStr, tc.key, tc.value))
+		Contains(t, bufT.buf.String(), tc.want)
+	}
+}
+
 func TestNotEmptyJSON(t *testing.T) {
 	mockT := new(testing.T)
 
@@ -1442,3 +1507,194 @@ func TestNotEmptyJSON(t *testing.T) {
 		t.Error("NotEmptyJSON should return true for struct")
 	}
 }
+
+type customAsError struct {
+	code int
+}
+
+func (e *customAsError) Error() string { return fmt.Sprintf("code %d", e.code) }
+
+func Test_ErrorAs(t *testing.T) {
+	mockT := new(testing.T)
+
+	err := fmt.Errorf("wrapped: %w", &customAsError{code: 404})
+
+	var target *customAsError
+	if True(t, ErrorAs(mockT, err, &target)) {
+		Equal(t, 404, target.code)
+	}
+
+	var pathErr *os.PathError
+	False(t, ErrorAs(mockT, err, &pathErr))
+	False(t, ErrorAs(mockT, err, nil))
+	False(t, ErrorAs(mockT, err, target))
+	False(t, ErrorAs(mockT, nil, &target))
+}
+
+func Test_ErrorContains(t *testing.T) {
+	mockT := new(testing.T)
+
+	err := fmt.Errorf("load config: %w", os.ErrNotExist)
+
+	True(t, ErrorContains(mockT, err, "file does not exist"))
+	False(t, ErrorContains(mockT, err, "permission denied"))
+	False(t, ErrorContains(mockT, nil, "load config"))
+}
+
+func Test_ErrorMatches(t *testing.T) {
+	mockT := new(testing.T)
+
+	err := fmt.Errorf("load config: %w", os.ErrNotExist)
+
+	True(t, ErrorMatches(mockT, err, "^load config: .+ not exist$"))
+	True(t, ErrorMatches(mockT, err, regexp.MustCompile("config")))
+	False(t, ErrorMatches(mockT, err, "^config"))
+	False(t, ErrorMatches(mockT, nil, "config"))
+}
+
+func Test_ErrorChain(t *testing.T) {
+	mockT := new(testing.T)
+
+	errNotFound := errors.New("not found")
+	errPermission := errors.New("permission denied")
+	joined := errors.Join(errNotFound, errPermission)
+	err := fmt.Errorf("load config: %w", joined)
+
+	True(t, ErrorChain(mockT, err, []error{joined, errNotFound, errPermission}))
+	False(t, ErrorChain(mockT, err, []error{joined, errPermission, errNotFound}))
+	False(t, ErrorChain(mockT, err, []error{joined, errNotFound}))
+	False(t, ErrorChain(mockT, err, []error{joined, errNotFound, errPermission, AnError}))
+	False(t, ErrorChain(mockT, nil, []error{errNotFound}))
+	True(t, ErrorChain(mockT, errNotFound, nil))
+
+	// links are compared by identity or Is(error) bool, NOT by message
+	False(t, ErrorChain(mockT, err, []error{errors.New("not found\npermission denied"), errNotFound, errPermission}))
+	False(t, ErrorChain(mockT, fmt.Errorf("wrap: %w", errors.New("not found")), []error{errNotFound}))
+	True(t, ErrorChain(mockT, fmt.Errorf("wrap: %w", &customIsError{}), []error{errNotFound}))
+
+	bufT := &bufferT{}
+	False(t, ErrorChain(bufT, err, []error{joined, nil, errPermission}))
+	Contains(t, bufT.buf.String(), `Expected wrapped error #1 is <nil>, but got: "not found"`)
+
+	bufT = &bufferT{}
+	False(t, ErrorChain(bufT, errNotFound, []error{nil}))
+	Contains(t, bufT.buf.String(), "Expected wrapped error #0 is <nil>, but got: <none>")
+}
+
+// customIsError is an error which is the "not found" error by message.
+type customIsError struct{}
+
+func (*customIsError) Error() string {
+	return "custom"
+}
+
+func (*customIsError) Is(target error) bool {
+	return target != nil && target.Error() == "not found"
+}
+
+func Test_ErrorTree(t *testing.T) {
+	mockT := &bufferT{}
+
+	errNotFound := errors.New("not found")
+	errPermission := errors.New("permission denied")
+	err := fmt.Errorf("load config: %w", errors.Join(errNotFound, errPermission))
+
+	True(t, ErrorTree(mockT, err, []error{errPermission, errNotFound}))
+	True(t, ErrorTree(mockT, fmt.Errorf("wrap: %w", &customIsError{}), []error{errNotFound}))
+	False(t, ErrorTree(mockT, err, []error{errNotFound, AnError}))
+	False(t, ErrorTree(mockT, nil, []error{errNotFound}))
+
+	// errors are found by identity or Is(error) bool, NOT by message
+	bufT := &bufferT{}
+	False(t, ErrorTree(bufT, err, []error{errNotFound, errors.New("permission denied")}))
+	Contains(t, bufT.buf.String(), `Expected error tree contains "permission denied", but got unexpected "permission denied"`)
+
+	bufT = &bufferT{}
+	False(t, ErrorTree(bufT, err, []error{errNotFound}))
+	Contains(t, bufT.buf.String(), `Expected error tree of 1 error(s), but got unexpected "permission denied"`)
+
+	bufT = &bufferT{}
+	False(t, ErrorTree(bufT, err, []error{errNotFound, errPermission, nil}))
+	Contains(t, bufT.buf.String(), "Expected error tree contains <nil>")
+
+	output := mockT.buf.String()
+	Contains(t, output, "Tree:")
+	Contains(t, output, `*fmt.wrapError: "load config: not found\npermission denied"`)
+	Contains(t, output, `    *errors.errorString: "permission denied"`)
+}
+
+func TestContainsJSONWithQuery(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, ContainsJSON(mockT, jsonPathDocument, "/store/name", "golib"))
+	True(t, ContainsJSON(mockT, jsonPathDocument, "/store/items/1/price", 12))
+	True(t, ContainsJSON(mockT, jsonPathDocument, "$['store']['a.b']", "dotted"))
+	True(t, ContainsJSON(mockT, jsonPathDocument, "$.users[-1].age", 42))
+	True(t, ContainsJSON(mockT, jsonPathDocument, "$.users[?(@.name=='bob')].age", 25))
+	True(t, ContainsJSON(mockT, jsonPathDocument, "$.store.items[0].tags", []string{"new"}))
+
+	bufT := &bufferT{}
+	False(t, ContainsJSON(bufT, jsonPathDocument, "$.users[*].age", 31))
+	Contains(t, bufT.buf.String(), "matches 3 values, expected exactly one")
+
+	bufT = &bufferT{}
+	False(t, ContainsJSON(bufT, jsonPathDocument, "/store/items/7/id", 1))
+	Contains(t, bufT.buf.String(), `deepest resolved: "/store/items"`)
+
+	True(t, NotContainsJSON(mockT, jsonPathDocument, "/store/items/3"))
+	True(t, NotContainsJSON(mockT, jsonPathDocument, "$.users[?(@.age>50)]"))
+	False(t, NotContainsJSON(mockT, jsonPathDocument, "$.store.name"))
+
+	True(t, NotEmptyJSON(mockT, jsonPathDocument, "/users/0/name"))
+	False(t, NotEmptyJSON(mockT, jsonPathDocument, "$.users[5]"))
+
+	// NOTE: keys starting with $ other than JSONPath are dotted keys
+	True(t, ContainsJSON(mockT, `{"$schema": "x"}`, "$schema", "x"))
+	True(t, ContainsJSON(mockT, `{"$defs": {"id": 1}}`, "$defs.id", 1))
+	True(t, ContainsJSON(mockT, `{"id": 1}`, "$", map[string]int{"id": 1}))
+
+	// NOTE: keys starting with / which resolve nothing as JSON Pointer are dotted keys
+	True(t, ContainsJSON(mockT, `{"/api": 1}`, "/api", 1))
+	True(t, ContainsJSON(mockT, `{"/api": {"name": "golib"}}`, "/api.name", "golib"))
+	True(t, NotEmptyJSON(mockT, `{"/api": 1}`, "/api"))
+	False(t, NotContainsJSON(mockT, `{"/api": 1}`, "/api"))
+	True(t, ContainsJSON(mockT, `{"/api": 1, "api": 2}`, "/api", 2))
+}
+
+func TestJSONPathCount(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, JSONPathCount(mockT, jsonPathDocument, "$.store.items[*]", 3))
+	True(t, JSONPathCount(mockT, jsonPathDocument, "$..name", 7))
+	True(t, JSONPathCount(mockT, jsonPathDocument, "$.users[?(@.age>50)]", 0))
+	True(t, JSONPathCount(mockT, jsonPathDocument, "/store/name", 1))
+	False(t, JSONPathCount(mockT, jsonPathDocument, "$.store.items[*]", 2))
+	False(t, JSONPathCount(mockT, jsonPathDocument, "$.store[", 0))
+
+	bufT := &bufferT{}
+	False(t, JSONPathCount(bufT, jsonPathDocument, "$.users[?(@.age>50)].name", 1))
+	Contains(t, bufT.buf.String(), `deepest resolved: "$.users"`)
+}
+
+func TestJSONPathAllAndAny(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, JSONPathAll(mockT, jsonPathDocument, "$.users[?(@.age>40)].name", "carol"))
+	True(t, JSONPathAll(mockT, jsonPathDocument, "$.store.items[0].tags", []string{"new"}))
+	False(t, JSONPathAll(mockT, jsonPathDocument, "$.users[?(@.age>60)].name", "carol"))
+
+	bufT := &bufferT{}
+	False(t, JSONPathAll(bufT, jsonPathDocument, "$.users[*].age", 31))
+	Contains(t, bufT.buf.String(), "2 of 3 are not")
+	Contains(t, bufT.buf.String(), "$.users[1].age: 25")
+
+	True(t, JSONPathAny(mockT, jsonPathDocument, "$..price", 8.5))
+	True(t, JSONPathAny(mockT, jsonPathDocument, "$..price", 30))
+	False(t, JSONPathAny(mockT, jsonPathDocument, "$..price", 31))
+	False(t, JSONPathAny(mockT, jsonPathDocument, "$..missing", 31))
+
+	it := New(mockT)
+	True(t, it.JSONPathCount(jsonPathDocument, "$.users", 1))
+	False(t, it.JSONPathAll(jsonPathDocument, "$.users[*].name", "alice"))
+	True(t, it.JSONPathAny(jsonPathDocument, "$.users[*].name", "alice"))
+}
@@ -0,0 +1,278 @@
+// Package asserttest provides utilities for testing custom assertions built on top of assert.
+//
+//	func TestMyAssertion(t *testing.T) {
+//	  rec := asserttest.NewRecorder(t)
+//
+//	  MyAssertion(rec, "foo")
+//
+//	  rec.AssertFailedWith("Error", "Expected values are NOT equal")
+//	}
+package asserttest
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/golib/assert"
+)
+
+var (
+	// labelLine matches the first line of a labeled content, i.e. "\tError:   \tmessage".
+	labelLine = regexp.MustCompile(`^\t([^\t:]+):( *)\t(.*)$`)
+
+	// continuationLine matches the subsequent lines of a labeled content, i.e. "\t          \tmessage".
+	continuationLine = regexp.MustCompile(`^\t( *)\t(.*)$`)
+
+	// ansiEscapes matches colors of the output, i.e. "\x1b[0;33m".
+	ansiEscapes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+)
+
+// Label is a labeled content of a failure, i.e. Error, Messages.
+type Label struct {
+	Name    string
+	Content string
+}
+
+// Failure is a failure reported through Errorf of the Recorder.
+type Failure struct {
+	// Output is the text reported as is.
+	Output string
+
+	// Labels are all labeled contents of the failure in order,
+	// it is empty if the output is not produced by assert.Fail.
+	Labels []Label
+
+	Trace    []string
+	Error    string
+	Messages string
+}
+
+// Label returns content of the label, ok is false if the failure has no such label.
+func (f Failure) Label(name string) (content string, ok bool) {
+	for _, label := range f.Labels {
+		if label.Name == name {
+			return label.Content, true
+		}
+	}
+
+	return "", false
+}
+
+// Recorder implements assert.Testing by recording failures rather than reporting them,
+// and reports failures of its own assertions through the Testing it created with.
+type Recorder struct {
+	mu sync.Mutex
+	t  assert.Testing
+
+	failures  []Failure
+	logs      []string
+	failedNow bool
+}
+
+// NewRecorder creates a *Recorder for the Testing.
+func NewRecorder(t assert.Testing) *Recorder {
+	return &Recorder{
+		t: t,
+	}
+}
+
+// Errorf records the failure with labels parsed.
+func (r *Recorder) Errorf(format string, args ...interface{}) {
+	failure := parseFailure(fmt.Sprintf(format, args...))
+
+	r.mu.Lock()
+	r.failures = append(r.failures, failure)
+	r.mu.Unlock()
+}
+
+// FailNow records the call without exiting the running goroutine.
+func (r *Recorder) FailNow() {
+	r.mu.Lock()
+	r.failedNow = true
+	r.mu.Unlock()
+}
+
+// Helper does nothing, it makes assertions reporting without carriage return hacks.
+func (r *Recorder) Helper() {}
+
+// Logf records the log.
+func (r *Recorder) Logf(format string, args ...interface{}) {
+	r.mu.Lock()
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+	r.mu.Unlock()
+}
+
+// Failures returns all failures recorded.
+func (r *Recorder) Failures() []Failure {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Failure(nil), r.failures...)
+}
+
+// Logs returns all logs recorded.
+func (r *Recorder) Logs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.logs...)
+}
+
+// Failed returns true if any failure is recorded.
+func (r *Recorder) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.failures) > 0
+}
+
+// FailedNow returns true if FailNow is called.
+func (r *Recorder) FailedNow() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.failedNow
+}
+
+// Reset drops everything recorded.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failures = nil
+	r.logs = nil
+	r.failedNow = false
+}
+
+// AssertFailedWith asserts that a failure is recorded with the label containing substr.
+//
+//	rec.AssertFailedWith("Error", "Expected values are NOT equal")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Recorder) AssertFailedWith(label, substr string, formatAndArgs ...interface{}) bool {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	failures := r.Failures()
+	for _, failure := range failures {
+		if content, ok := failure.Label(label); ok && strings.Contains(content, substr) {
+			return true
+		}
+	}
+
+	return assert.Fail(r.t,
+		fmt.Sprintf("Expected a failure with %s containing %q, but got %d failure(s):\n%s",
+			label, substr, len(failures), formatFailures(failures)),
+		formatAndArgs...)
+}
+
+// AssertNotFailed asserts that no failure is recorded.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Recorder) AssertNotFailed(formatAndArgs ...interface{}) bool {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	failures := r.Failures()
+	if len(failures) > 0 {
+		return assert.Fail(r.t,
+			fmt.Sprintf("Expected no failure, but got %d failure(s):\n%s", len(failures), formatFailures(failures)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// AssertFailedNow asserts that FailNow is called.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Recorder) AssertFailedNow(formatAndArgs ...interface{}) bool {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	if !r.FailedNow() {
+		return assert.Fail(r.t, "Expected FailNow to be called", formatAndArgs...)
+	}
+
+	return true
+}
+
+// parseFailure parses output of assert.Fail into labels, both with and without carriage returns.
+func parseFailure(output string) Failure {
+	failure := Failure{
+		Output: output,
+	}
+
+	var (
+		label    *Label
+		contents []string
+		flush    = func() {
+			if label != nil {
+				label.Content = strings.Join(contents, "\n")
+				failure.Labels = append(failure.Labels, *label)
+			}
+		}
+	)
+	// NOTE: labels and diffs are colored if colors are enabled
+	for _, line := range strings.Split(ansiEscapes.ReplaceAllString(output, ""), "\n") {
+		// NOTE: the carriage returns are used to overwrite the file:line prefix of testing.T
+		if i := strings.LastIndex(line, "\r"); i >= 0 {
+			line = line[i+1:]
+		}
+
+		if matches := labelLine.FindStringSubmatch(line); matches != nil && !isBlank(matches[1]) {
+			flush()
+
+			label = &Label{Name: matches[1]}
+			contents = []string{matches[3]}
+			continue
+		}
+
+		if label == nil {
+			continue
+		}
+
+		if matches := continuationLine.FindStringSubmatch(line); matches != nil {
+			contents = append(contents, matches[2])
+		}
+	}
+	flush()
+
+	for _, label := range failure.Labels {
+		switch label.Name {
+		case "Trace":
+			for _, line := range strings.Split(label.Content, "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					failure.Trace = append(failure.Trace, line)
+				}
+			}
+
+		case "Error":
+			failure.Error = label.Content
+
+		case "Messages":
+			failure.Messages = label.Content
+		}
+	}
+
+	return failure
+}
+
+func isBlank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+// formatFailures returns outputs of failures for reporting.
+func formatFailures(failures []Failure) string {
+	outputs := make([]string, 0, len(failures))
+	for i, failure := range failures {
+		outputs = append(outputs, fmt.Sprintf("#%d: %s", i+1, strings.TrimSpace(strings.ReplaceAll(failure.Output, "\r", ""))))
+	}
+
+	return strings.Join(outputs, "\n")
+}
@@ -0,0 +1,116 @@
+package asserttest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golib/assert"
+)
+
+// legacyT is a minimal Testing which reports with carriage return hacks.
+type legacyT struct {
+	outputs []string
+}
+
+func (t *legacyT) Errorf(format string, args ...interface{}) {
+	t.outputs = append(t.outputs, fmt.Sprintf(format, args...))
+}
+
+func assertPositive(t assert.Testing, n int, formatAndArgs ...interface{}) bool {
+	if n <= 0 {
+		return assert.Fail(t, fmt.Sprintf("Expected %d to be positive", n), formatAndArgs...)
+	}
+
+	return true
+}
+
+func TestRecorder(t *testing.T) {
+	rec := NewRecorder(t)
+
+	True := func(ok bool) {
+		t.Helper()
+
+		if !ok {
+			t.Error("Expected true, but got false")
+		}
+	}
+
+	True(assertPositive(rec, 1))
+	True(rec.AssertNotFailed())
+
+	True(!assertPositive(rec, -1, "hello, %s!", "world"))
+	True(rec.Failed())
+	True(rec.AssertFailedWith("Error", "Expected -1 to be positive"))
+	True(rec.AssertFailedWith("Messages", "hello, world!"))
+
+	failures := rec.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("Expected 1 failure, but got %d", len(failures))
+	}
+	if failures[0].Error != "Expected -1 to be positive" || failures[0].Messages != "hello, world!" {
+		t.Errorf("Unexpected failure parsed: %#v", failures[0])
+	}
+	if strings.Contains(failures[0].Output, "\r") {
+		t.Errorf("Expected output without carriage return, but got %q", failures[0].Output)
+	}
+
+	rec.Reset()
+	True(!rec.Failed())
+
+	True(!assert.FailNow(rec, "stop"))
+	True(rec.FailedNow())
+	True(rec.AssertFailedNow())
+	True(rec.AssertFailedWith("Error", "stop"))
+}
+
+func TestRecorderFailure(t *testing.T) {
+	mockT := NewRecorder(t)
+	rec := NewRecorder(mockT)
+
+	rec.AssertFailedWith("Error", "anything")
+	rec.AssertFailedNow()
+	assertPositive(rec, 0)
+	rec.AssertNotFailed()
+
+	if failures := mockT.Failures(); len(failures) != 3 {
+		t.Errorf("Expected 3 failures, but got %d", len(failures))
+	}
+	mockT.AssertFailedWith("Error", `Expected a failure with Error containing "anything", but got 0 failure(s)`)
+	mockT.AssertFailedWith("Error", "Expected FailNow to be called")
+	mockT.AssertFailedWith("Error", "Expected no failure, but got 1 failure(s)")
+}
+
+func Test_parseFailure(t *testing.T) {
+	legacy := &legacyT{}
+	assert.Equal(legacy, "want", "got", "hello")
+
+	rec := NewRecorder(t)
+	assert.Equal(rec, "want", "got", "hello")
+
+	for _, output := range []string{legacy.outputs[0], rec.Failures()[0].Output} {
+		failure := parseFailure(output)
+
+		if failure.Messages != "hello" {
+			t.Errorf("Expected messages of %q, but got %q", output, failure.Messages)
+		}
+		if !strings.HasPrefix(failure.Error, "Expected values are NOT equal.\n") ||
+			!strings.Contains(failure.Error, `-"want"`) ||
+			!strings.Contains(failure.Error, `+"got"`) {
+			t.Errorf("Expected error of %q, but got %q", output, failure.Error)
+		}
+
+		names := make([]string, 0, len(failure.Labels))
+		for _, label := range failure.Labels {
+			names = append(names, label.Name)
+		}
+		if strings.Join(names, ",") != "Trace,Error,Messages" {
+			t.Errorf("Expected labels of %q, but got %v", output, names)
+		}
+	}
+
+	failure := parseFailure("custom failure")
+	if failure.Output != "custom failure" || len(failure.Labels) != 0 {
+		t.Errorf("Unexpected failure parsed: %#v", failure)
+	}
+}
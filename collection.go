@@ -0,0 +1,400 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/kr/pretty"
+)
+
+// elementCount is an element of collection with the count of equal elements.
+type elementCount struct {
+	value any
+	count int
+}
+
+// collectionElements returns elements of slice, array, map keys or iter.Seq,
+// nil is an empty collection.
+func collectionElements(v any) ([]any, bool) {
+	if v == nil {
+		return nil, true
+	}
+
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		elements := make([]any, value.Len())
+		for i := range elements {
+			elements[i] = value.Index(i).Interface()
+		}
+
+		return elements, true
+
+	case reflect.Map:
+		elements := make([]any, 0, value.Len())
+		for keys := value.MapRange(); keys.Next(); {
+			elements = append(elements, keys.Key().Interface())
+		}
+
+		return elements, true
+
+	case reflect.Func:
+		if !isSeq(value.Type()) {
+			return nil, false
+		}
+		if value.IsNil() {
+			return nil, true
+		}
+
+		var elements []any
+		yield := reflect.MakeFunc(value.Type().In(0), func(args []reflect.Value) []reflect.Value {
+			elements = append(elements, args[0].Interface())
+
+			return []reflect.Value{reflect.ValueOf(true)}
+		})
+		value.Call([]reflect.Value{yield})
+
+		return elements, true
+	}
+
+	return nil, false
+}
+
+// isSeq returns whether the type is of iter.Seq, i.e. func(yield func(V) bool).
+func isSeq(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Func || typ.NumIn() != 1 || typ.NumOut() != 0 {
+		return false
+	}
+
+	yield := typ.In(0)
+
+	return yield.Kind() == reflect.Func &&
+		yield.NumIn() == 1 && yield.NumOut() == 1 &&
+		yield.Out(0).Kind() == reflect.Bool
+}
+
+// elementCounts groups equal elements with counts in order of their first appearances.
+// Elements of hashable types are bucketed by map, the rest are compared by AreEqualObjects one by one.
+type elementCounts struct {
+	list   []elementCount
+	hashed map[any]int
+	others []int
+}
+
+// countElements returns elementCounts of the elements.
+func countElements(elements []any) *elementCounts {
+	counts := &elementCounts{
+		hashed: map[any]int{},
+	}
+	for _, element := range elements {
+		if i := counts.indexOf(element); i >= 0 {
+			counts.list[i].count++
+			continue
+		}
+
+		i := len(counts.list)
+		counts.list = append(counts.list, elementCount{value: element, count: 1})
+
+		if isHashable(element) {
+			counts.hashed[element] = i
+		} else {
+			counts.others = append(counts.others, i)
+		}
+	}
+
+	return counts
+}
+
+// indexOf returns the index of element counted, or -1 if not found.
+func (counts *elementCounts) indexOf(element any) int {
+	if isHashable(element) {
+		if i, ok := counts.hashed[element]; ok {
+			return i
+		}
+
+		return -1
+	}
+
+	for _, i := range counts.others {
+		if AreEqualObjects(counts.list[i].value, element) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// countOf returns the count of element, or 0 if not found.
+func (counts *elementCounts) countOf(element any) int {
+	if i := counts.indexOf(element); i >= 0 {
+		return counts.list[i].count
+	}
+
+	return 0
+}
+
+// isHashable returns true if the value is comparable by ==, which is the same as AreEqualObjects.
+func isHashable(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	typ := reflect.TypeOf(v)
+	if ok, found := hashableTypes.Load(typ); found {
+		return ok.(bool)
+	}
+
+	ok := isHashableType(typ)
+	hashableTypes.Store(typ, ok)
+
+	return ok
+}
+
+var hashableTypes sync.Map
+
+// isHashableType returns true if values of the type are equal by == if and only if they are equal
+// by AreEqualObjects, i.e. no pointer, interface or Equal(T) bool or Cmp(T) int method at any depth.
+func isHashableType(typ reflect.Type) bool {
+	if method, ok := typ.MethodByName("Equal"); ok && isCompareMethod(method.Type, typ, boolType) {
+		return false
+	}
+	if method, ok := typ.MethodByName("Cmp"); ok && isCompareMethod(method.Type, typ, intType) {
+		return false
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Chan, reflect.UnsafePointer:
+		return true
+
+	case reflect.Array:
+		return isHashableType(typ.Elem())
+
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if !isHashableType(typ.Field(i).Type) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// diffElements returns elements of expected missing from actual, and elements of actual extra to expected,
+// with the differences of their counts as multisets.
+func diffElements(expected, actual []any) (missing, extra []elementCount) {
+	expectedCounts := countElements(expected)
+	actualCounts := countElements(actual)
+
+	for _, v := range expectedCounts.list {
+		if n := v.count - actualCounts.countOf(v.value); n > 0 {
+			missing = append(missing, elementCount{value: v.value, count: n})
+		}
+	}
+
+	for _, v := range actualCounts.list {
+		if n := v.count - expectedCounts.countOf(v.value); n > 0 {
+			extra = append(extra, elementCount{value: v.value, count: n})
+		}
+	}
+
+	return
+}
+
+// filterElements returns counts of elements, which are in other or not, of the elements.
+func filterElements(elements, other []any, in bool) []elementCount {
+	otherCounts := countElements(other)
+
+	var counts []elementCount
+	for _, v := range countElements(elements).list {
+		if (otherCounts.indexOf(v.value) >= 0) == in {
+			counts = append(counts, v)
+		}
+	}
+
+	return counts
+}
+
+// formatElementCounts returns elements with counts line by line, i.e. "foo" (x2).
+func formatElementCounts(counts []elementCount) string {
+	lines := make([]string, 0, len(counts))
+	for _, v := range counts {
+		lines = append(lines, fmt.Sprintf("%s (x%d)", formatValue(reflect.ValueOf(v.value)), v.count))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// elementsContent returns labeledContent of element counts which are not empty.
+func elementsContent(labels []string, counts ...[]elementCount) []labeledContent {
+	var content []labeledContent
+	for i, label := range labels {
+		if len(counts[i]) > 0 {
+			content = append(content, labeledContent{label, formatElementCounts(counts[i])})
+		}
+	}
+
+	return content
+}
+
+// collectionsOf returns elements of both collections, and reports the failure if any is not a collection.
+func collectionsOf(t Testing, a, b any, formatAndArgs ...any) (as, bs []any, ok bool) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	// NOTE: collections are iterated once, iter.Seq may not be iterated again
+	as, ok = collectionElements(a)
+	if !ok {
+		return nil, nil, Fail(t,
+			fmt.Sprintf("%T is not a slice, array, map or iter.Seq", a),
+			formatAndArgs...)
+	}
+
+	bs, ok = collectionElements(b)
+	if !ok {
+		return nil, nil, Fail(t,
+			fmt.Sprintf("%T is not a slice, array, map or iter.Seq", b),
+			formatAndArgs...)
+	}
+
+	return as, bs, true
+}
+
+// ElementsMatch asserts that both collections have the same elements with the same counts in any order.
+// Collections are slices, arrays, map keys or iter.Seq, elements are compared by AreEqualObjects.
+//
+//	assert.ElementsMatch(t, []int{1, 3, 2, 3}, []int{3, 3, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ElementsMatch(t Testing, expected, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	es, as, ok := collectionsOf(t, expected, actual, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	missing, extra := diffElements(es, as)
+	if len(missing) > 0 || len(extra) > 0 {
+		return failWithContent(t,
+			"Expected elements to match in any order, but they do NOT",
+			elementsContent([]string{"Missing", "Extra"}, missing, extra),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// NotElementsMatch asserts that both collections do NOT have the same elements with the same counts.
+// See ElementsMatch for collections supported.
+//
+//	assert.NotElementsMatch(t, []int{1, 2, 3}, []int{1, 2, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotElementsMatch(t Testing, expected, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	es, as, ok := collectionsOf(t, expected, actual, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	missing, extra := diffElements(es, as)
+	if len(missing) == 0 && len(extra) == 0 {
+		return Fail(t,
+			pretty.Sprintf("Expected elements NOT to match in any order, but %#v matches %#v", actual, expected),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// Subset asserts that every element of the subset is in the list, regardless of counts.
+// See ElementsMatch for collections supported.
+//
+//	assert.Subset(t, []string{"foo", "bar", "baz"}, []string{"baz", "foo"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Subset(t Testing, list, subset any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	ls, ss, ok := collectionsOf(t, list, subset, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	if missing := filterElements(ss, ls, false); len(missing) > 0 {
+		return failWithContent(t,
+			pretty.Sprintf("Expected %#v to be a subset of %#v, but it is NOT", subset, list),
+			elementsContent([]string{"Missing"}, missing),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// NotSubset asserts that any element of the subset is NOT in the list.
+// See ElementsMatch for collections supported.
+//
+//	assert.NotSubset(t, []string{"foo", "bar"}, []string{"foo", "baz"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotSubset(t Testing, list, subset any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	ls, ss, ok := collectionsOf(t, list, subset, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	if missing := filterElements(ss, ls, false); len(missing) == 0 {
+		return Fail(t,
+			pretty.Sprintf("Expected %#v NOT to be a subset of %#v, but it is", subset, list),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// Disjoint asserts that both collections have no element in common.
+// See ElementsMatch for collections supported.
+//
+//	assert.Disjoint(t, []int{1, 2}, []int{3, 4})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Disjoint(t Testing, a, b any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	as, bs, ok := collectionsOf(t, a, b, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	if common := filterElements(as, bs, true); len(common) > 0 {
+		return failWithContent(t,
+			pretty.Sprintf("Expected %#v and %#v to be disjoint, but they are NOT", a, b),
+			elementsContent([]string{"Common"}, common),
+			formatAndArgs...)
+	}
+
+	return true
+}
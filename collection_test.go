@@ -0,0 +1,110 @@
+package assert
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func Test_ElementsMatch(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, ElementsMatch(mockT, []int{1, 3, 2, 3}, []int{3, 3, 1, 2}))
+	True(t, ElementsMatch(mockT, [3]string{"a", "b", "c"}, []string{"c", "b", "a"}))
+	True(t, ElementsMatch(mockT, map[string]int{"a": 1, "b": 2}, []string{"b", "a"}))
+	True(t, ElementsMatch(mockT, slices.Values([]int{1, 2}), []int{2, 1}))
+	True(t, ElementsMatch(mockT, maps.Keys(map[int]bool{1: true}), []int{1}))
+	True(t, ElementsMatch(mockT, nil, []int{}))
+	True(t, ElementsMatch(mockT, []*int{nil}, []*int{nil}))
+	True(t, ElementsMatch(mockT, [][]byte{[]byte("a")}, [][]byte{[]byte("a")}))
+
+	False(t, ElementsMatch(mockT, []int{1, 2}, []int{1, 2, 2}))
+	False(t, ElementsMatch(mockT, []int{1}, "1"))
+
+	mockTB := &mockTBTesting{}
+	False(t, ElementsMatch(mockTB, []string{"a", "b", "b", "b", "c"}, []string{"b", "a", "d"}))
+	Contains(t, mockTB.buf.String(), "Expected elements to match in any order, but they do NOT\n")
+	Contains(t, mockTB.buf.String(), "\tMissing:\t\"b\" (x2)\n\t\t\t        \t\"c\" (x1)\n")
+	Contains(t, mockTB.buf.String(), "\tExtra:  \t\"d\" (x1)\n")
+
+	mockTB = &mockTBTesting{}
+	False(t, ElementsMatch(mockTB, []int{1}, 1))
+	Contains(t, mockTB.buf.String(), "int is not a slice, array, map or iter.Seq")
+}
+
+func Test_ElementsMatchHashable(t *testing.T) {
+	mockT := new(testing.T)
+
+	expected := make([]int, 10000)
+	actual := make([]int, len(expected))
+	for i := range expected {
+		expected[i] = i
+		actual[len(actual)-1-i] = i
+	}
+	True(t, ElementsMatch(mockT, expected, actual))
+
+	// NOTE: elements with Equal method or pointers are compared by AreEqualObjects
+	now := time.Now()
+	True(t, ElementsMatch(mockT, []time.Time{now, now}, []time.Time{now.UTC(), now.In(time.Local)}))
+	True(t, ElementsMatch(mockT, []*int{new(int)}, []*int{new(int)}))
+	True(t, ElementsMatch(mockT, []any{1, []int{1}}, []any{[]int{1}, 1}))
+	False(t, ElementsMatch(mockT, []float64{math.NaN()}, []float64{math.NaN()}))
+
+	True(t, isHashable(nil))
+	True(t, isHashable([2]string{"a", "b"}))
+	True(t, isHashable(struct{ name string }{}))
+	False(t, isHashable(now))
+	False(t, isHashable(struct{ ptr *int }{}))
+	False(t, isHashable(struct{ value any }{}))
+}
+
+func Test_NotElementsMatch(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, NotElementsMatch(mockT, []int{1, 2, 3}, []int{1, 2, 2}))
+	True(t, NotElementsMatch(mockT, []int{1}, []int64{1}))
+	False(t, NotElementsMatch(mockT, []int{1, 2}, slices.Values([]int{2, 1})))
+}
+
+func Test_Subset(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, Subset(mockT, []string{"foo", "bar", "baz"}, []string{"baz", "foo", "foo"}))
+	True(t, Subset(mockT, map[string]int{"foo": 1, "bar": 2}, []string{"bar"}))
+	True(t, Subset(mockT, []string{"foo"}, nil))
+	False(t, Subset(mockT, []string{"foo"}, []string{"bar"}))
+
+	mockTB := &mockTBTesting{}
+	False(t, Subset(mockTB, []int{1, 2}, []int{1, 3, 3, 4}))
+	Contains(t, mockTB.buf.String(), "\tMissing:\t3 (x2)\n\t\t\t        \t4 (x1)\n")
+
+	True(t, NotSubset(mockT, []string{"foo", "bar"}, []string{"foo", "baz"}))
+	False(t, NotSubset(mockT, []string{"foo", "bar"}, []string{"foo"}))
+	False(t, NotSubset(mockT, []string{"foo", "bar"}, nil))
+}
+
+func Test_Disjoint(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, Disjoint(mockT, []int{1, 2}, []int{3, 4}))
+	True(t, Disjoint(mockT, []int{1, 2}, nil))
+
+	mockTB := &mockTBTesting{}
+	False(t, Disjoint(mockTB, []int{1, 2, 2, 3}, map[int]string{2: "two", 3: "three"}))
+	Contains(t, mockTB.buf.String(), "\tCommon:\t2 (x2)\n\t\t\t       \t3 (x1)\n")
+}
+
+func Test_SameSetOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, SameSetOf(mockT, []string{"foo", "bar"}, []string{"bar", "foo", "bar"}))
+	False(t, SameSetOf(mockT, []string{"foo"}, []string{"bar"}))
+
+	mockTB := &mockTBTesting{}
+	False(t, SameSetOf(mockTB, []int{1, 2, 2}, []int{1, 3}))
+	Contains(t, mockTB.buf.String(), "Expected the same set of elements, but they are NOT\n")
+	Contains(t, mockTB.buf.String(), "\tMissing:\t2 (x2)\n")
+	Contains(t, mockTB.buf.String(), "\tExtra:  \t3 (x1)\n")
+}
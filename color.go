@@ -0,0 +1,204 @@
+package assert
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/dolab/colorize"
+)
+
+// ColorMode is the mode of colors in failure output.
+type ColorMode int
+
+const (
+	// ColorAuto colors output if not disabled by environment, and both stdout and stderr are terminals.
+	// The environment is resolved in order:
+	//
+	//   - GOLIB_ASSERT_COLOR of always, never or auto;
+	//   - NO_COLOR of any non-empty value disables colors;
+	//   - FORCE_COLOR of any non-empty value other than 0 and false enables colors.
+	ColorAuto ColorMode = iota
+
+	// ColorAlways colors output regardless of environment.
+	ColorAlways
+
+	// ColorNever never colors output.
+	ColorNever
+)
+
+// Palette is the colors of failure output, each of which is a style of github.com/dolab/colorize,
+// i.e. "red", "blue+b" and "white:red". An empty style leaves the part plain.
+type Palette struct {
+	Label   string // labels of output, i.e. Error:
+	Removed string // lines of diffs removed from the expected
+	Added   string // lines of diffs added to the actual
+	Context string // headers and lines of diffs in common
+}
+
+// DefaultPalette returns the palette used by default.
+func DefaultPalette() Palette {
+	return Palette{
+		Label:   "yellow",
+		Removed: "red",
+		Added:   "blue",
+		Context: "gray",
+	}
+}
+
+// colorSettings is settings of colors for the Testing, mode and palette not set fall back to the global.
+type colorSettings struct {
+	mode    *ColorMode
+	palette *Palette
+}
+
+var (
+	colors = newGlobalSettings(colorSettings{})
+
+	// NOTE: the environment and terminals are resolved once for ColorAuto
+	colorAuto = sync.OnceValue(func() bool {
+		return detectColor(os.Getenv, isTerminal(os.Stdout) && isTerminal(os.Stderr))
+	})
+)
+
+// SetColor sets the mode of colors of all failures, it is ColorAuto by default.
+func SetColor(mode ColorMode) {
+	colors.set(func(settings *colorSettings) {
+		settings.mode = &mode
+	})
+}
+
+// SetPalette sets the palette of all failures, it is DefaultPalette by default.
+func SetPalette(palette Palette) {
+	colors.set(func(settings *colorSettings) {
+		settings.palette = &palette
+	})
+}
+
+// WithColor sets the mode of colors of failures reported through the Assertions,
+// which takes precedence over SetColor.
+func WithColor(mode ColorMode) Option {
+	return func(it *Assertions) {
+		it.updateSettings(func(settings *settings) {
+			settings.color.mode = &mode
+		})
+	}
+}
+
+// WithPalette sets the palette of failures reported through the Assertions,
+// which takes precedence over SetPalette.
+func WithPalette(palette Palette) Option {
+	return func(it *Assertions) {
+		it.updateSettings(func(settings *settings) {
+			settings.color.palette = &palette
+		})
+	}
+}
+
+// colorPolicy is the resolved colors of failure output.
+type colorPolicy struct {
+	enabled bool
+	palette Palette
+}
+
+// colorOf returns the colorPolicy of the Testing.
+func colorOf(t Testing) colorPolicy {
+	settings := colors.get()
+	if local := settingsOf(t); local != nil {
+		if local.color.mode != nil {
+			settings.mode = local.color.mode
+		}
+		if local.color.palette != nil {
+			settings.palette = local.color.palette
+		}
+	}
+
+	policy := colorPolicy{
+		palette: DefaultPalette(),
+	}
+	if settings.palette != nil {
+		policy.palette = *settings.palette
+	}
+
+	mode := ColorAuto
+	if settings.mode != nil {
+		mode = *settings.mode
+	}
+	switch mode {
+	case ColorAlways:
+		policy.enabled = true
+	case ColorNever:
+		policy.enabled = false
+	default:
+		policy.enabled = colorAuto()
+	}
+
+	return policy
+}
+
+// diffColorOf returns the colorPolicy of diffs of the Testing. Diffs are painted for the default text
+// output only, they are plain for other Reporters, i.e. JSONReporter and JUnitReporter.
+func diffColorOf(t Testing) colorPolicy {
+	switch reporterOf(t).(type) {
+	case nil, TextReporter:
+		return colorOf(t)
+	}
+
+	return colorPolicy{}
+}
+
+// detectColor returns whether to color output by the environment, falls back to whether output is a terminal.
+func detectColor(getenv func(key string) string, terminal bool) bool {
+	switch strings.ToLower(strings.TrimSpace(getenv("GOLIB_ASSERT_COLOR"))) {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+
+	if getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	switch force := strings.ToLower(getenv("FORCE_COLOR")); force {
+	case "", "0", "false":
+	default:
+		return true
+	}
+
+	return terminal
+}
+
+// isTerminal returns whether the file is a terminal.
+func isTerminal(file *os.File) bool {
+	if file == nil {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// paint returns the text painted with the style, it is the text as is for an empty style.
+func paint(style, text string) string {
+	if style == "" {
+		return text
+	}
+
+	painter := colorize.New(style)
+
+	return painter.Paint(text)
+}
+
+// label returns the label painted with the palette.
+func (p colorPolicy) label(label string) string {
+	if !p.enabled {
+		return label
+	}
+
+	return paint(p.palette.Label, label)
+}
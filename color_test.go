@@ -0,0 +1,113 @@
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func Test_DetectColor(t *testing.T) {
+	for _, tc := range []struct {
+		env      map[string]string
+		terminal bool
+		expected bool
+	}{
+		{nil, false, false},
+		{nil, true, true},
+		{map[string]string{"NO_COLOR": "1"}, true, false},
+		{map[string]string{"FORCE_COLOR": "1"}, false, true},
+		{map[string]string{"FORCE_COLOR": "0"}, true, true},
+		{map[string]string{"FORCE_COLOR": "false"}, false, false},
+		{map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "1"}, true, false},
+		{map[string]string{"GOLIB_ASSERT_COLOR": "always", "NO_COLOR": "1"}, false, true},
+		{map[string]string{"GOLIB_ASSERT_COLOR": "Never", "FORCE_COLOR": "1"}, true, false},
+		{map[string]string{"GOLIB_ASSERT_COLOR": "auto", "FORCE_COLOR": "1"}, false, true},
+	} {
+		getenv := func(key string) string {
+			return tc.env[key]
+		}
+
+		Equal(t, tc.expected, detectColor(getenv, tc.terminal), "env %v with terminal %v", tc.env, tc.terminal)
+	}
+}
+
+func Test_WithColor(t *testing.T) {
+	mockT := &mockNamedTesting{name: "TestColor"}
+	it := New(mockT, WithColor(ColorNever))
+
+	False(t, it.Equal("want", "got"))
+	NotContains(t, mockT.buf.String(), "\x1b[")
+	Contains(t, mockT.buf.String(), "\tError:\tExpected values are NOT equal.")
+	Contains(t, mockT.buf.String(), `-"want"`)
+
+	mockT.buf.Reset()
+	New(mockT, WithColor(ColorAlways)).Equal("want", "got")
+	Contains(t, mockT.buf.String(), "\x1b[0;33mError:\x1b[0m\tExpected values are NOT equal.")
+	Contains(t, mockT.buf.String(), "\x1b[0;31m-\"want\"\x1b[0m")
+
+	// settings apply to the Assertions only, neither plain calls nor other Assertions of the Testing
+	SetColor(ColorAlways)
+	defer SetColor(ColorAuto)
+
+	mockT.buf.Reset()
+	it.Equal("want", "got")
+	NotContains(t, mockT.buf.String(), "\x1b[")
+
+	mockT.buf.Reset()
+	Equal(mockT, "want", "got")
+	New(mockT).Equal("want", "got")
+	Equal(t, 2, strings.Count(mockT.buf.String(), "\x1b[0;33mError:\x1b[0m"))
+}
+
+func Test_WithPalette(t *testing.T) {
+	mockT := &mockNamedTesting{name: "TestPalette"}
+	defer mockT.cleanup()
+
+	palette := Palette{
+		Removed: "magenta",
+		Added:   "green+b",
+	}
+	it := New(mockT, WithColor(ColorAlways), WithPalette(palette))
+
+	False(t, it.Equal("want", "got", "hello, %s!", "world"))
+	Contains(t, mockT.buf.String(), "\tMessages:\thello, world!")
+	Contains(t, mockT.buf.String(), "\x1b[0;35m-\"want\"\x1b[0m")
+	Contains(t, mockT.buf.String(), "\x1b[0;1;32m+\"got\"\x1b[0m")
+	Contains(t, mockT.buf.String(), "\t@@ -1 +1 @@\n")
+
+	// global palette is overridden by the Testing
+	SetPalette(Palette{Label: "cyan"})
+	defer SetPalette(DefaultPalette())
+
+	otherT := &mockNamedTesting{name: "TestGlobalPalette"}
+	defer otherT.cleanup()
+
+	New(otherT, WithColor(ColorAlways)).Fail("failed")
+	Contains(t, otherT.buf.String(), "\x1b[0;36mError:\x1b[0m")
+
+	mockT.buf.Reset()
+	it.Fail("failed")
+	Contains(t, mockT.buf.String(), "\tError:\tfailed")
+}
+
+func Test_ColorOfUserContent(t *testing.T) {
+	mockT := &mockNamedTesting{name: "TestUserColor"}
+
+	// escapes of messages are kept as is, colors apply to labels and diffs only
+	False(t, New(mockT, WithColor(ColorNever)).Equal("want", "got", "\x1b[1mbold\x1b[0m"))
+	Contains(t, mockT.buf.String(), "\tMessages:\t\x1b[1mbold\x1b[0m")
+	NotContains(t, mockT.buf.String(), "\x1b[0;31m")
+
+	mockT.buf.Reset()
+	New(mockT, WithColor(ColorAlways), WithPalette(Palette{Removed: "magenta"})).Equal("want", "got", "\x1b[0;31mred\x1b[0m")
+	Contains(t, mockT.buf.String(), "\x1b[0;35m-\"want\"\x1b[0m")
+	Contains(t, mockT.buf.String(), "\x1b[0;31mred\x1b[0m")
+
+	// diffs are plain for reporters other than TextReporter
+	buf := new(bytes.Buffer)
+
+	mockT.buf.Reset()
+	New(mockT, WithColor(ColorAlways), WithReporter(NewJSONReporter(buf))).Equal("want", "got", "\x1b[1mbold\x1b[0m")
+	Contains(t, buf.String(), `"diff":"--- Expected\n+++ Actual`)
+	Contains(t, buf.String(), `"content":"\u001b[1mbold\u001b[0m"`)
+}
@@ -0,0 +1,303 @@
+package assert
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kr/pretty"
+)
+
+// CompareOption config comparison of EqualWith and NotEqualWith.
+type CompareOption func(opts *compareOptions)
+
+// compareOptions holds options applied by differ while walking values.
+type compareOptions struct {
+	ignoreFields       map[string]bool
+	ignoreUnexported   bool
+	ignoreEqualMethods bool
+	equateEmpty        bool
+
+	approx       bool
+	approxFrac   float64
+	approxMargin float64
+
+	comparers map[reflect.Type]func(expected, actual reflect.Value) bool
+	sorters   map[reflect.Type]func(x, y reflect.Value) bool
+}
+
+func newCompareOptions(options ...CompareOption) compareOptions {
+	var opts compareOptions
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	return opts
+}
+
+// IgnoreFields ignores struct fields with the names at any depth.
+//
+//	assert.EqualWith(t, expected, actual, assert.IgnoreFields("ID", "CreatedAt"))
+func IgnoreFields(names ...string) CompareOption {
+	return func(opts *compareOptions) {
+		if opts.ignoreFields == nil {
+			opts.ignoreFields = map[string]bool{}
+		}
+
+		for _, name := range names {
+			opts.ignoreFields[name] = true
+		}
+	}
+}
+
+// IgnoreUnexported ignores unexported struct fields at any depth.
+func IgnoreUnexported() CompareOption {
+	return func(opts *compareOptions) {
+		opts.ignoreUnexported = true
+	}
+}
+
+// IgnoreEqualMethods compares values by walking them even if they have Equal(T) bool or
+// Cmp(T) int method, which is the same as reflect.DeepEqual.
+func IgnoreEqualMethods() CompareOption {
+	return func(opts *compareOptions) {
+		opts.ignoreEqualMethods = true
+	}
+}
+
+// EquateEmpty treats nil and empty slices or maps of the same type as equal.
+func EquateEmpty() CompareOption {
+	return func(opts *compareOptions) {
+		opts.equateEmpty = true
+	}
+}
+
+// EquateApprox treats floats as equal if the difference of them is within
+// the margin, or the fraction of the smaller absolute one.
+// NaN is never equal to anything, and negative fraction or margin are treated as 0.
+//
+//	assert.EqualWith(t, 0.3, sum, assert.EquateApprox(0, 1e-9))
+func EquateApprox(fraction, margin float64) CompareOption {
+	return func(opts *compareOptions) {
+		opts.approx = true
+		opts.approxFrac = math.Max(fraction, 0)
+		opts.approxMargin = math.Max(margin, 0)
+	}
+}
+
+// Comparer compares values of type T with fn rather than walking them.
+// NOTE: values held by unexported fields cannot be passed to fn, they are walked as usual.
+//
+//	assert.EqualWith(t, expected, actual, assert.Comparer(func(a, b time.Time) bool {
+//		return a.Equal(b)
+//	}))
+func Comparer[T any](fn func(a, b T) bool) CompareOption {
+	return func(opts *compareOptions) {
+		if opts.comparers == nil {
+			opts.comparers = map[reflect.Type]func(expected, actual reflect.Value) bool{}
+		}
+
+		opts.comparers[reflect.TypeFor[T]()] = func(expected, actual reflect.Value) bool {
+			// NOTE: nil interface values are passed as zero value of T
+			a, _ := expected.Interface().(T)
+			b, _ := actual.Interface().(T)
+
+			return fn(a, b)
+		}
+	}
+}
+
+// SortSlices sorts copies of slices with element of type T by less before comparing them,
+// which ignores order of elements.
+// NOTE: slices held by unexported fields cannot be sorted, they are walked in order.
+//
+//	assert.EqualWith(t, []int{1, 2}, []int{2, 1}, assert.SortSlices(func(a, b int) bool {
+//		return a < b
+//	}))
+func SortSlices[T any](less func(a, b T) bool) CompareOption {
+	return func(opts *compareOptions) {
+		if opts.sorters == nil {
+			opts.sorters = map[reflect.Type]func(x, y reflect.Value) bool{}
+		}
+
+		opts.sorters[reflect.TypeFor[T]()] = func(x, y reflect.Value) bool {
+			a, _ := x.Interface().(T)
+			b, _ := y.Interface().(T)
+
+			return less(a, b)
+		}
+	}
+}
+
+// isIgnoredField returns true if the struct field should not be compared.
+func (opts *compareOptions) isIgnoredField(field reflect.StructField) bool {
+	if opts.ignoreUnexported && !field.IsExported() {
+		return true
+	}
+
+	return opts.ignoreFields[field.Name]
+}
+
+// compare returns the result of comparer registered for type of both values,
+// ok is false if there is no comparer applicable.
+func (opts *compareOptions) compare(expected, actual reflect.Value) (equal, ok bool) {
+	if len(opts.comparers) == 0 {
+		return false, false
+	}
+
+	fn, ok := opts.comparers[expected.Type()]
+	if !ok || !expected.CanInterface() || !actual.CanInterface() {
+		return false, false
+	}
+
+	return fn(expected, actual), true
+}
+
+// equalMethod returns the result of Equal(T) bool or Cmp(T) int method of expected,
+// ok is false if there is no method applicable.
+func (opts *compareOptions) equalMethod(expected, actual reflect.Value) (equal, ok bool) {
+	if opts.ignoreEqualMethods || !expected.CanInterface() || !actual.CanInterface() {
+		return false, false
+	}
+
+	switch expected.Kind() {
+	case reflect.Interface:
+		// NOTE: methods are looked up with the concrete value
+		return false, false
+
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		// avoid calling methods with nil receiver or argument
+		if expected.IsNil() || actual.IsNil() {
+			return false, false
+		}
+	}
+
+	typ := expected.Type()
+
+	if method, ok := typ.MethodByName("Equal"); ok && isCompareMethod(method.Type, typ, boolType) {
+		return expected.Method(method.Index).Call([]reflect.Value{actual})[0].Bool(), true
+	}
+
+	if method, ok := typ.MethodByName("Cmp"); ok && isCompareMethod(method.Type, typ, intType) {
+		return expected.Method(method.Index).Call([]reflect.Value{actual})[0].Int() == 0, true
+	}
+
+	return false, false
+}
+
+var (
+	boolType = reflect.TypeFor[bool]()
+	intType  = reflect.TypeFor[int]()
+)
+
+// isCompareMethod returns true if the method is of func(typ, typ) out.
+func isCompareMethod(method, typ, out reflect.Type) bool {
+	return method.NumIn() == 2 && method.In(1) == typ &&
+		method.NumOut() == 1 && method.Out(0) == out
+}
+
+// sortSlice returns a sorted copy of the slice if there is a sorter registered for its element.
+func (opts *compareOptions) sortSlice(v reflect.Value) reflect.Value {
+	less, ok := opts.sorters[v.Type().Elem()]
+	if !ok || !v.CanInterface() || v.Len() < 2 {
+		return v
+	}
+
+	sorted := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(sorted, v)
+
+	sort.SliceStable(sorted.Interface(), func(i, j int) bool {
+		return less(sorted.Index(i), sorted.Index(j))
+	})
+
+	return sorted
+}
+
+// isApproxEqual compares floats with the margin and fraction of EquateApprox.
+func (opts *compareOptions) isApproxEqual(expected, actual float64) bool {
+	if math.IsNaN(expected) || math.IsNaN(actual) {
+		return false
+	}
+
+	if expected == actual {
+		return true
+	}
+
+	delta := math.Abs(expected - actual)
+
+	return delta <= opts.approxMargin || delta <= opts.approxFrac*math.Min(math.Abs(expected), math.Abs(actual))
+}
+
+// areEqualWith gets whether two objects are equal with the options.
+func areEqualWith(expected, actual any, options ...CompareOption) bool {
+	d := newDiffer(1, options...)
+	d.quiet = true
+	d.walk(nil, reflect.ValueOf(expected), reflect.ValueOf(actual))
+
+	return len(d.diffs) == 0
+}
+
+// EqualWith asserts that two objects are equal with the options.
+//
+//	assert.EqualWith(t, expected, actual, assert.IgnoreFields("ID"), assert.EquateEmpty())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EqualWith(t Testing, expected, actual any, options ...CompareOption) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return equalWith(t, expected, actual, options)
+}
+
+// NotEqualWith asserts that two objects are NOT equal with the options.
+//
+//	assert.NotEqualWith(t, expected, actual, assert.IgnoreUnexported())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotEqualWith(t Testing, expected, actual any, options ...CompareOption) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return notEqualWith(t, expected, actual, options)
+}
+
+func equalWith(t Testing, expected, actual any, options []CompareOption, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !areEqualWith(expected, actual, options...) {
+		diff := diffObjects(diffColorOf(t), expected, actual, options...)
+
+		return failWithValues(t,
+			"Expected values are NOT equal."+diff,
+			failureValues{
+				expected: pretty.Sprintf("%#v", expected),
+				actual:   pretty.Sprintf("%#v", actual),
+				diff:     strings.TrimSpace(diff),
+			},
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+func notEqualWith(t Testing, expected, actual any, options []CompareOption, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if areEqualWith(expected, actual, options...) {
+		expected, actual = prettifyValues(expected, actual)
+
+		return Fail(t, pretty.Sprintf(
+			"Expected values are NOT equal in value.%s",
+			diffValues(diffColorOf(t), expected, actual),
+		), formatAndArgs...)
+	}
+
+	return true
+}
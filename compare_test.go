@@ -0,0 +1,117 @@
+package assert
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+type compareUser struct {
+	ID        int
+	Name      string
+	Tags      []string
+	Scores    map[string]float64
+	CreatedAt time.Time
+	secret    string
+}
+
+func Test_EqualWithIgnoreFields(t *testing.T) {
+	expected := compareUser{ID: 1, Name: "golib", CreatedAt: time.Now()}
+	actual := compareUser{ID: 2, Name: "golib", CreatedAt: time.Now().Add(time.Second)}
+
+	mockT := &bufferT{}
+	False(t, EqualWith(mockT, expected, actual))
+	Contains(t, mockT.buf.String(), ".ID: 1 != 2")
+
+	True(t, EqualWith(t, expected, actual, IgnoreFields("ID", "CreatedAt")))
+	True(t, EqualWith(t, []*compareUser{&expected}, []*compareUser{&actual}, IgnoreFields("ID", "CreatedAt")))
+
+	mockT = &bufferT{}
+	actual.Name = "assert"
+	False(t, EqualWith(mockT, expected, actual, IgnoreFields("ID", "CreatedAt")))
+	Contains(t, mockT.buf.String(), `.Name: "golib" != "assert"`)
+	NotContains(t, mockT.buf.String(), ".ID")
+}
+
+func Test_EqualWithIgnoreUnexported(t *testing.T) {
+	expected := compareUser{Name: "golib", secret: "foo"}
+	actual := compareUser{Name: "golib", secret: "bar"}
+
+	False(t, EqualWith(&bufferT{}, expected, actual))
+	True(t, EqualWith(t, expected, actual, IgnoreUnexported()))
+}
+
+func Test_EqualWithEquateEmpty(t *testing.T) {
+	False(t, EqualWith(&bufferT{}, []int(nil), []int{}))
+	True(t, EqualWith(t, []int(nil), []int{}, EquateEmpty()))
+	True(t, EqualWith(t, compareUser{}, compareUser{Tags: []string{}, Scores: map[string]float64{}}, EquateEmpty()))
+	False(t, EqualWith(&bufferT{}, []int(nil), []int{1}, EquateEmpty()))
+}
+
+func Test_EqualWithEquateApprox(t *testing.T) {
+	a, b := 0.1, 0.2
+
+	False(t, EqualWith(&bufferT{}, 0.3, a+b))
+	True(t, EqualWith(t, 0.3, a+b, EquateApprox(0, 1e-9)))
+	True(t, EqualWith(t, 100.0, 101.0, EquateApprox(0.01, 0)))
+	False(t, EqualWith(&bufferT{}, 100.0, 102.0, EquateApprox(0.01, 0)))
+	False(t, EqualWith(&bufferT{}, math.NaN(), math.NaN(), EquateApprox(1, 1)))
+	True(t, EqualWith(t,
+		compareUser{Scores: map[string]float64{"a": 1.0}},
+		compareUser{Scores: map[string]float64{"a": 1.0000001}},
+		EquateApprox(0, 1e-3),
+	))
+}
+
+func Test_EqualWithComparer(t *testing.T) {
+	now := time.Now()
+	expected := compareUser{CreatedAt: now}
+	actual := compareUser{CreatedAt: now.In(time.FixedZone("UTC+8", 8*3600))}
+
+	False(t, EqualWith(&bufferT{}, expected, actual, IgnoreEqualMethods()))
+	True(t, EqualWith(t, expected, actual, IgnoreEqualMethods(), Comparer(func(a, b time.Time) bool {
+		return a.Equal(b)
+	})))
+
+	caseInsensitive := Comparer(func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	})
+	True(t, EqualWith(t, []string{"Foo"}, []string{"foo"}, caseInsensitive))
+	False(t, EqualWith(&bufferT{}, []string{"Foo"}, []string{"bar"}, caseInsensitive))
+}
+
+func Test_EqualWithSortSlices(t *testing.T) {
+	byValue := SortSlices(func(a, b int) bool {
+		return a < b
+	})
+
+	expected := []int{1, 2, 3}
+	actual := []int{3, 1, 2}
+
+	False(t, EqualWith(&bufferT{}, expected, actual))
+	True(t, EqualWith(t, expected, actual, byValue))
+	Equal(t, []int{3, 1, 2}, actual)
+	False(t, EqualWith(&bufferT{}, expected, []int{3, 1, 1}, byValue))
+}
+
+func Test_NotEqualWith(t *testing.T) {
+	True(t, NotEqualWith(t, compareUser{ID: 1}, compareUser{ID: 2}))
+
+	mockT := &bufferT{}
+	False(t, NotEqualWith(mockT, compareUser{ID: 1}, compareUser{ID: 2}, IgnoreFields("ID")))
+	Contains(t, mockT.buf.String(), "Expected values are NOT equal in value.")
+}
+
+func TestAssertionsWithCompareOptions(t *testing.T) {
+	it := New(t, WithCompareOptions(IgnoreFields("ID"), EquateEmpty()))
+
+	it.Equal(compareUser{ID: 1}, compareUser{ID: 2, Tags: []string{}})
+	it.NotEqual(compareUser{Name: "foo"}, compareUser{Name: "bar"})
+	it.EqualWith(compareUser{ID: 1, secret: "foo"}, compareUser{ID: 2}, IgnoreUnexported())
+	it.NotEqualWith(compareUser{Tags: []string{"foo"}}, compareUser{})
+
+	mockT := &bufferT{}
+	False(t, New(mockT, WithCompareOptions(IgnoreFields("ID"))).Equal(compareUser{Name: "foo"}, compareUser{Name: "bar"}, "with %s", "message"))
+	Contains(t, mockT.buf.String(), "with message")
+}
@@ -0,0 +1,495 @@
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+
+	"github.com/kr/pretty"
+)
+
+// maxObjectDiffs limits mismatches listed by diffObjects.
+const maxObjectDiffs = 32
+
+// objectDiff is a mismatch found by walking two values, with the path of it.
+type objectDiff struct {
+	path     string
+	expected string
+	actual   string
+}
+
+func (d objectDiff) String() string {
+	path := d.path
+	if path == "" {
+		path = "."
+	}
+
+	return fmt.Sprintf("%s: %s != %s", path, d.expected, d.actual)
+}
+
+// differ walks two values with reflection and records every mismatch with the path of it,
+// i.e. .Users[3].Address.Zip. Unlike reflect.DeepEqual, it reports all mismatches rather
+// than the first one, and it does not require fields to be exported.
+type differ struct {
+	diffs   []objectDiff
+	limit   int
+	quiet   bool
+	opts    compareOptions
+	visited map[differVisit]bool
+}
+
+// differPath is the path of values walked, i.e. .Users[3].Address.Zip, which is formatted
+// only if a mismatch is reported. Walking equal values thus builds no strings.
+type differPath struct {
+	parent *differPath
+	field  string
+	index  int
+	key    reflect.Value
+}
+
+func (p *differPath) withField(name string) *differPath {
+	return &differPath{parent: p, field: name}
+}
+
+func (p *differPath) withIndex(i int) *differPath {
+	return &differPath{parent: p, index: i}
+}
+
+func (p *differPath) withKey(key reflect.Value) *differPath {
+	return &differPath{parent: p, key: key}
+}
+
+func (p *differPath) String() string {
+	if p == nil {
+		return ""
+	}
+
+	switch {
+	case p.field != "":
+		return p.parent.String() + "." + p.field
+
+	case p.key.IsValid():
+		return fmt.Sprintf("%s[%s]", p.parent.String(), formatValue(p.key))
+	}
+
+	return fmt.Sprintf("%s[%d]", p.parent.String(), p.index)
+}
+
+// differVisit is a pair of references visited already, which avoids walking cyclic values endlessly.
+type differVisit struct {
+	expected unsafe.Pointer
+	actual   unsafe.Pointer
+	typ      reflect.Type
+}
+
+func newDiffer(limit int, options ...CompareOption) *differ {
+	return &differ{
+		limit: limit,
+		opts:  newCompareOptions(options...),
+	}
+}
+
+// done returns true if the differ has recorded enough mismatches.
+func (d *differ) done() bool {
+	return d.limit > 0 && len(d.diffs) >= d.limit
+}
+
+func (d *differ) report(path *differPath, expected, actual string) {
+	d.diffs = append(d.diffs, objectDiff{
+		path:     path.String(),
+		expected: expected,
+		actual:   actual,
+	})
+}
+
+// reportValues records a mismatch of both values, which are formatted unless the differ is quiet.
+func (d *differ) reportValues(path *differPath, expected, actual reflect.Value) {
+	d.reportWith(path, expected, actual, formatValue)
+}
+
+// reportTypedValues records a mismatch of both values of different types.
+func (d *differ) reportTypedValues(path *differPath, expected, actual reflect.Value) {
+	d.reportWith(path, expected, actual, formatTypedValue)
+}
+
+// reportMissing records a mismatch of a value missing from the other, the missing one is invalid.
+func (d *differ) reportMissing(path *differPath, expected, actual reflect.Value) {
+	d.reportWith(path, expected, actual, func(v reflect.Value) string {
+		if !v.IsValid() {
+			return "<missing>"
+		}
+
+		return formatValue(v)
+	})
+}
+
+func (d *differ) reportWith(path *differPath, expected, actual reflect.Value, format func(v reflect.Value) string) {
+	if d.done() {
+		return
+	}
+
+	// NOTE: formatting values is expensive, it's skipped if only equality matters
+	if d.quiet {
+		d.diffs = append(d.diffs, objectDiff{})
+
+		return
+	}
+
+	d.report(path, format(expected), format(actual))
+}
+
+// isVisited marks references of both values as visited, and returns true if they were visited before.
+func (d *differ) isVisited(expected, actual reflect.Value) bool {
+	var ep, ap unsafe.Pointer
+	switch expected.Kind() {
+	case reflect.Map, reflect.Ptr, reflect.Slice:
+		ep, ap = expected.UnsafePointer(), actual.UnsafePointer()
+	default:
+		return false
+	}
+
+	key := differVisit{ep, ap, expected.Type()}
+	if d.visited[key] {
+		return true
+	}
+
+	if d.visited == nil {
+		d.visited = map[differVisit]bool{}
+	}
+
+	d.visited[key] = true
+
+	return false
+}
+
+func (d *differ) walk(path *differPath, expected, actual reflect.Value) {
+	if d.done() {
+		return
+	}
+
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() != actual.IsValid() {
+			d.reportValues(path, expected, actual)
+		}
+
+		return
+	}
+
+	if expected.Type() != actual.Type() {
+		d.reportTypedValues(path, expected, actual)
+
+		return
+	}
+
+	if equal, ok := d.opts.compare(expected, actual); ok {
+		if !equal {
+			d.reportValues(path, expected, actual)
+		}
+
+		return
+	}
+
+	if equal, ok := d.opts.equalMethod(expected, actual); ok {
+		if !equal {
+			d.reportValues(path, expected, actual)
+		}
+
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Array:
+		for i := 0; i < expected.Len() || i < actual.Len(); i++ {
+			d.walkIndex(path, i, expected, actual)
+		}
+
+	case reflect.Slice:
+		if d.opts.equateEmpty && expected.Len() == 0 && actual.Len() == 0 {
+			return
+		}
+
+		if expected.IsNil() != actual.IsNil() {
+			d.reportValues(path, expected, actual)
+
+			return
+		}
+
+		if expected.Len() == actual.Len() && expected.UnsafePointer() == actual.UnsafePointer() {
+			return
+		}
+
+		// NOTE: bytes are compared at once, mismatches are located by walking them
+		if d.isBytes(expected.Type()) && bytes.Equal(expected.Bytes(), actual.Bytes()) {
+			return
+		}
+
+		if d.isVisited(expected, actual) {
+			return
+		}
+
+		expected, actual = d.opts.sortSlice(expected), d.opts.sortSlice(actual)
+
+		for i := 0; i < expected.Len() || i < actual.Len(); i++ {
+			d.walkIndex(path, i, expected, actual)
+		}
+
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				d.reportValues(path, expected, actual)
+			}
+
+			return
+		}
+
+		d.walk(path, expected.Elem(), actual.Elem())
+
+	case reflect.Ptr:
+		if expected.UnsafePointer() == actual.UnsafePointer() {
+			return
+		}
+
+		if expected.IsNil() || actual.IsNil() {
+			d.reportValues(path, expected, actual)
+
+			return
+		}
+
+		if d.isVisited(expected, actual) {
+			return
+		}
+
+		d.walk(path, expected.Elem(), actual.Elem())
+
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			if d.opts.isIgnoredField(field) {
+				continue
+			}
+
+			d.walk(path.withField(field.Name), expected.Field(i), actual.Field(i))
+		}
+
+	case reflect.Map:
+		if d.opts.equateEmpty && expected.Len() == 0 && actual.Len() == 0 {
+			return
+		}
+
+		if expected.IsNil() != actual.IsNil() {
+			d.reportValues(path, expected, actual)
+
+			return
+		}
+
+		if expected.UnsafePointer() == actual.UnsafePointer() {
+			return
+		}
+
+		if d.isVisited(expected, actual) {
+			return
+		}
+
+		keys := unionMapKeys(expected, actual)
+		if !d.quiet {
+			keys = sortMapKeys(keys)
+		}
+
+		for _, key := range keys {
+			keyPath := path.withKey(key)
+
+			ev, av := expected.MapIndex(key), actual.MapIndex(key)
+			switch {
+			case !ev.IsValid(), !av.IsValid():
+				d.reportMissing(keyPath, ev, av)
+
+			default:
+				d.walk(keyPath, ev, av)
+			}
+		}
+
+	default:
+		switch expected.Kind() {
+		case reflect.Float32, reflect.Float64:
+			if d.opts.approx {
+				if !d.opts.isApproxEqual(expected.Float(), actual.Float()) {
+					d.reportValues(path, expected, actual)
+				}
+
+				return
+			}
+		}
+
+		if !isEqualScalar(expected, actual) {
+			d.reportValues(path, expected, actual)
+		}
+	}
+}
+
+// isBytes returns true if the type is a slice of bytes, which are compared as is.
+func (d *differ) isBytes(typ reflect.Type) bool {
+	elem := typ.Elem()
+	if elem.Kind() != reflect.Uint8 || elem.NumMethod() > 0 {
+		return false
+	}
+
+	_, ok := d.opts.comparers[elem]
+
+	return !ok
+}
+
+func (d *differ) walkIndex(path *differPath, i int, expected, actual reflect.Value) {
+	indexPath := path.withIndex(i)
+
+	switch {
+	case i >= expected.Len():
+		d.reportMissing(indexPath, reflect.Value{}, actual.Index(i))
+
+	case i >= actual.Len():
+		d.reportMissing(indexPath, expected.Index(i), reflect.Value{})
+
+	default:
+		d.walk(indexPath, expected.Index(i), actual.Index(i))
+	}
+}
+
+// isEqualScalar compares values of the same type which are not containers,
+// the values are not required to be exported.
+func isEqualScalar(expected, actual reflect.Value) bool {
+	switch expected.Kind() {
+	case reflect.Bool:
+		return expected.Bool() == actual.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return expected.Int() == actual.Int()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return expected.Uint() == actual.Uint()
+
+	case reflect.Float32, reflect.Float64:
+		return expected.Float() == actual.Float()
+
+	case reflect.Complex64, reflect.Complex128:
+		return expected.Complex() == actual.Complex()
+
+	case reflect.String:
+		return expected.String() == actual.String()
+
+	case reflect.Chan, reflect.UnsafePointer:
+		return expected.UnsafePointer() == actual.UnsafePointer()
+
+	case reflect.Func:
+		// same as reflect.DeepEqual, funcs are equal only if both are nil
+		return expected.IsNil() && actual.IsNil()
+	}
+
+	return false
+}
+
+// unionMapKeys returns union of keys of both maps.
+func unionMapKeys(expected, actual reflect.Value) []reflect.Value {
+	keys := expected.MapKeys()
+	for _, key := range actual.MapKeys() {
+		if !expected.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// sortMapKeys sorts keys by its formatted value.
+func sortMapKeys(keys []reflect.Value) []reflect.Value {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = formatValue(key)
+	}
+
+	sort.Stable(mapKeysByName{keys, names})
+
+	return keys
+}
+
+type mapKeysByName struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (s mapKeysByName) Len() int           { return len(s.keys) }
+func (s mapKeysByName) Less(i, j int) bool { return s.names[i] < s.names[j] }
+func (s mapKeysByName) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.names[i], s.names[j] = s.names[j], s.names[i]
+}
+
+// formatValue returns a Go syntax representation of v, which is not required to be exported.
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+
+	if v.CanInterface() {
+		return pretty.Sprintf("%#v", v.Interface())
+	}
+
+	// fmt prints the value held by reflect.Value without calling Interface()
+	return fmt.Sprintf("%#v", v)
+}
+
+// formatTypedValue returns formatValue of v with its type, i.e. int64(1).
+// Values other than bool, number and string are formatted with type already.
+func formatTypedValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return fmt.Sprintf("%v(%s)", v.Type(), formatValue(v))
+	}
+
+	return formatValue(v)
+}
+
+// diffObjects returns mismatches of both values with paths, as long as both are of
+// the same kind of struct, map, slice, array or pointer. Otherwise, it returns diffValues
+// painted with the colorPolicy.
+func diffObjects(policy colorPolicy, expected, actual interface{}, options ...CompareOption) string {
+	if expected == nil || actual == nil {
+		return diffValues(policy, expected, actual)
+	}
+
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if expectedValue.Kind() != actualValue.Kind() {
+		return diffValues(policy, expected, actual)
+	}
+
+	switch expectedValue.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr:
+		d := newDiffer(maxObjectDiffs+1, options...)
+		d.walk(nil, expectedValue, actualValue)
+
+		if len(d.diffs) > 0 {
+			return formatObjectDiffs(d.diffs)
+		}
+	}
+
+	return diffValues(policy, expected, actual)
+}
+
+// formatObjectDiffs returns mismatches line by line.
+func formatObjectDiffs(diffs []objectDiff) string {
+	lines := make([]string, 0, len(diffs))
+	for i, diff := range diffs {
+		if i == maxObjectDiffs {
+			lines = append(lines, "...")
+			break
+		}
+
+		lines = append(lines, diff.String())
+	}
+
+	return fmt.Sprintf("\n\n%s\n", strings.Join(lines, "\n"))
+}
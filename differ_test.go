@@ -0,0 +1,103 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+type differAddress struct {
+	Street string
+	Zip    string
+}
+
+type differUser struct {
+	Name    string
+	Address *differAddress
+	tags    []string
+}
+
+type differGroup struct {
+	Users []differUser
+	Meta  map[string]interface{}
+}
+
+type differNode struct {
+	Value int
+	Next  *differNode
+}
+
+func Test_diffObjects(t *testing.T) {
+	expected := differGroup{
+		Users: []differUser{
+			{Name: "foo", Address: &differAddress{Street: "1st", Zip: "10001"}},
+			{Name: "bar", tags: []string{"admin"}},
+		},
+		Meta: map[string]interface{}{"b": 2, "a": 1, "c": []int{1}},
+	}
+	actual := differGroup{
+		Users: []differUser{
+			{Name: "foo", Address: &differAddress{Street: "1st", Zip: "10002"}},
+			{Name: "bar", tags: []string{"user"}},
+			{Name: "baz"},
+		},
+		Meta: map[string]interface{}{"a": 1, "b": int64(2), "d": true},
+	}
+
+	diffs := diffObjects(colorPolicy{}, expected, actual)
+	Equal(t, strings.Join([]string{
+		"",
+		"",
+		`.Users[0].Address.Zip: "10001" != "10002"`,
+		`.Users[1].tags[0]: "admin" != "user"`,
+		`.Users[2]: <missing> != assert.differUser{Name:"baz", Address:(*assert.differAddress)(nil), tags:[]string(nil)}`,
+		`.Meta["b"]: int(2) != int64(2)`,
+		`.Meta["c"]: []int{1} != <missing>`,
+		`.Meta["d"]: <missing> != true`,
+		"",
+	}, "\n"), diffs)
+}
+
+func Test_diffObjectsWithCycle(t *testing.T) {
+	expected := &differNode{Value: 1}
+	expected.Next = &differNode{Value: 2, Next: expected}
+
+	actual := &differNode{Value: 1}
+	actual.Next = &differNode{Value: 3, Next: actual}
+
+	Equal(t, "\n\n.Next.Value: 2 != 3\n", diffObjects(colorPolicy{}, expected, actual))
+}
+
+func Test_diffObjectsFallback(t *testing.T) {
+	Equal(t, diffValues(colorPolicy{}, "foo", "bar"), diffObjects(colorPolicy{}, "foo", "bar"))
+	Equal(t, diffValues(colorPolicy{}, 1, "1"), diffObjects(colorPolicy{}, 1, "1"))
+	Equal(t, diffValues(colorPolicy{}, nil, []int{1}), diffObjects(colorPolicy{}, nil, []int{1}))
+}
+
+func Test_diffObjectsLimit(t *testing.T) {
+	expected := make([]int, maxObjectDiffs+10)
+	actual := make([]int, maxObjectDiffs+10)
+	for i := range actual {
+		actual[i] = i + 1
+	}
+
+	lines := strings.Split(strings.TrimSpace(diffObjects(colorPolicy{}, expected, actual)), "\n")
+	Len(t, lines, maxObjectDiffs+1)
+	Equal(t, "...", lines[maxObjectDiffs])
+}
+
+func Test_diffObjectsBytes(t *testing.T) {
+	expected := []byte("golib")
+	actual := []byte("gopib")
+
+	True(t, AreEqualObjects(expected, []byte("golib")))
+	False(t, AreEqualObjects(expected, actual))
+	Equal(t, "\n\n[2]: 0x6c != 0x70\n", diffObjects(colorPolicy{}, expected, actual))
+	Equal(t, "\n\n[5]: <missing> != 0x21\n", diffObjects(colorPolicy{}, expected, []byte("golib!")))
+}
+
+func Test_EqualWithObjectDiffs(t *testing.T) {
+	mockT := &bufferT{}
+
+	Equal(mockT, differUser{Name: "foo"}, differUser{Name: "bar"})
+	Contains(t, mockT.buf.String(), `.Name: "foo" != "bar"`)
+}
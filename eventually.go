@@ -0,0 +1,285 @@
+package assert
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/kr/pretty"
+)
+
+// Eventually asserts that the comp is satisfied within waitFor, checking it at once and then every tick.
+// The comp is called in a goroutine, so that it fails in time even if the comp blocks, thus the comp
+// must NOT call FailNow of the Testing, which is allowed in the goroutine of the test only. A comp still
+// running after the assertion is waited for on cleanup of the Testing up to a second, and fails the test
+// if it never returns, there is no waiting for Testing without Cleanup(func()).
+//
+//	assert.Eventually(t, func() bool { return done.Load() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Eventually(t Testing, comp Comparison, waitFor, tick time.Duration, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return EventuallyContext(context.Background(), t, comp, waitFor, tick, formatAndArgs...)
+}
+
+// EventuallyContext is the same as Eventually, except it stops polling once the ctx is done.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EventuallyContext(ctx context.Context, t Testing, comp Comparison, waitFor, tick time.Duration, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if tick <= 0 {
+		return Fail(t,
+			pretty.Sprintf("Expected a positive tick, but got %v", tick),
+			formatAndArgs...)
+	}
+
+	ok, err := poll(ctx, t, waitFor, tick, comp)
+	if ok {
+		return true
+	}
+
+	if err != nil {
+		return Fail(t,
+			pretty.Sprintf("Condition is NOT satisfied, polling is canceled: %v", err),
+			formatAndArgs...)
+	}
+
+	return Fail(t,
+		pretty.Sprintf("Condition is NOT satisfied within %v", waitFor),
+		formatAndArgs...)
+}
+
+// Never asserts that the comp is never satisfied within waitFor, checking it at once and then every tick.
+// The comp is called in a goroutine, the same as Eventually.
+//
+//	assert.Never(t, func() bool { return closed.Load() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Never(t Testing, comp Comparison, waitFor, tick time.Duration, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return NeverContext(context.Background(), t, comp, waitFor, tick, formatAndArgs...)
+}
+
+// NeverContext is the same as Never, except it stops polling once the ctx is done.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NeverContext(ctx context.Context, t Testing, comp Comparison, waitFor, tick time.Duration, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	started := time.Now()
+
+	if tick <= 0 {
+		return Fail(t,
+			pretty.Sprintf("Expected a positive tick, but got %v", tick),
+			formatAndArgs...)
+	}
+
+	ok, err := poll(ctx, t, waitFor, tick, comp)
+	if ok {
+		return Fail(t,
+			pretty.Sprintf("Condition is satisfied after %v, but it should never be within %v", time.Since(started), waitFor),
+			formatAndArgs...)
+	}
+
+	if err != nil {
+		return Fail(t,
+			pretty.Sprintf("Condition is NOT observed for %v, polling is canceled: %v", waitFor, err),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// EventuallyWithT asserts that all checks of the fn pass within waitFor, calling it every tick.
+// The fn is called with a soft *Assertions, failures of the last round are reported
+// if the waitFor is exceeded. The fn is called in a goroutine, the same as Eventually, it should
+// fail with the c rather than the Testing.
+//
+//	assert.EventuallyWithT(t, func(c *assert.Assertions) {
+//	  c.Equal("ready", job.Status())
+//	  c.Empty(job.Errors())
+//	}, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EventuallyWithT(t Testing, fn func(c *Assertions), waitFor, tick time.Duration, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return EventuallyWithTContext(context.Background(), t, fn, waitFor, tick, formatAndArgs...)
+}
+
+// EventuallyWithTContext is the same as EventuallyWithT, except it stops polling once the ctx is done.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EventuallyWithTContext(ctx context.Context, t Testing, fn func(c *Assertions), waitFor, tick time.Duration, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if tick <= 0 {
+		return Fail(t,
+			pretty.Sprintf("Expected a positive tick, but got %v", tick),
+			formatAndArgs...)
+	}
+
+	// NOTE: the fn of a round may be running after polling, only rounds completed are reported
+	var last atomic.Pointer[collectT]
+
+	ok, err := poll(ctx, t, waitFor, tick, func() bool {
+		c := new(collectT)
+		c.run(fn, settingsOf(t))
+		last.Store(c)
+
+		return len(c.failures) == 0
+	})
+	if ok {
+		return true
+	}
+
+	var report []labeledContent
+	if c := last.Load(); c != nil {
+		report = c.report()
+	}
+
+	if err != nil {
+		return failWithContent(t,
+			pretty.Sprintf("Condition is NOT satisfied, polling is canceled: %v", err),
+			report,
+			formatAndArgs...)
+	}
+
+	return failWithContent(t,
+		pretty.Sprintf("Condition is NOT satisfied within %v", waitFor),
+		report,
+		formatAndArgs...)
+}
+
+// Eventually asserts that the comp is satisfied within waitFor, checking it every tick.
+//
+//	it.Eventually(func() bool { return done.Load() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Eventually(comp Comparison, waitFor, tick time.Duration, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Eventually(it.t, comp, waitFor, tick, formatAndArgs...)
+}
+
+// Never asserts that the comp is never satisfied within waitFor, checking it every tick.
+//
+//	it.Never(func() bool { return closed.Load() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Never(comp Comparison, waitFor, tick time.Duration, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Never(it.t, comp, waitFor, tick, formatAndArgs...)
+}
+
+// EventuallyWithT asserts that all checks of the fn pass within waitFor, calling it every tick.
+//
+//	it.EventuallyWithT(func(c *assert.Assertions) {
+//	  c.Equal("ready", job.Status())
+//	}, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) EventuallyWithT(fn func(c *Assertions), waitFor, tick time.Duration, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return EventuallyWithT(it.t, fn, waitFor, tick, formatAndArgs...)
+}
+
+// pollCleanupTimeout is how long a test waits on cleanup for the fn still running after polling.
+const pollCleanupTimeout = time.Second
+
+// poll calls fn at once and then every tick, until it returns true, the waitFor is exceeded or the ctx is done.
+// The fn is called in a goroutine, so that a blocking fn cannot hang the caller, ticks are skipped
+// while it is running. If the fn is still running after poll returns, the test waits for it on cleanup
+// up to pollCleanupTimeout, and fails if it never returns.
+//
+// It returns (true, nil) if fn was satisfied, (false, nil) if the waitFor was exceeded,
+// and (false, ctx.Err()) if the ctx was done before. Panics of fn are raised within the caller's goroutine.
+func poll(ctx context.Context, t Testing, waitFor, tick time.Duration, fn func() bool) (bool, error) {
+	// NOTE: results are buffered, so that the goroutine of fn always quits
+	results := make(chan func() bool, 1)
+	running := false
+
+	call := func() {
+		running = true
+
+		go func() {
+			defer func() {
+				if err := recover(); err != nil {
+					results <- func() bool { panic(err) }
+				}
+			}()
+
+			ok := fn()
+			results <- func() bool { return ok }
+		}()
+	}
+
+	defer func() {
+		if !running {
+			return
+		}
+
+		if c, ok := testingOf(t).(cleaner); ok {
+			c.Cleanup(func() {
+				select {
+				case <-results:
+				case <-time.After(pollCleanupTimeout):
+					Fail(t, pretty.Sprintf("Condition is still running %v after the test, it is leaked", pollCleanupTimeout))
+				}
+			})
+		}
+	}()
+
+	timer := time.NewTimer(waitFor)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	call()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+
+		case <-timer.C:
+			return false, nil
+
+		case <-ticker.C:
+			if !running {
+				call()
+			}
+
+		case result := <-results:
+			running = false
+
+			if result() {
+				return true, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package assert
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_Eventually(t *testing.T) {
+	mockT := new(testing.T)
+
+	var n int32
+	True(t, Eventually(mockT, func() bool {
+		return atomic.AddInt32(&n, 1) == 3
+	}, 100*time.Millisecond, time.Millisecond))
+
+	False(t, Eventually(mockT, func() bool {
+		return false
+	}, 10*time.Millisecond, time.Millisecond))
+}
+
+func Test_EventuallyContext(t *testing.T) {
+	mockT := &bufferT{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	False(t, EventuallyContext(ctx, mockT, func() bool {
+		return false
+	}, time.Second, time.Millisecond))
+	Contains(t, mockT.buf.String(), "polling is canceled: context canceled")
+}
+
+func Test_Never(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, Never(mockT, func() bool {
+		return false
+	}, 10*time.Millisecond, time.Millisecond))
+
+	var n int32
+	False(t, Never(mockT, func() bool {
+		return atomic.AddInt32(&n, 1) == 3
+	}, 100*time.Millisecond, time.Millisecond))
+}
+
+func Test_NeverContext(t *testing.T) {
+	mockT := new(testing.T)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	False(t, NeverContext(ctx, mockT, func() bool {
+		return false
+	}, time.Second, time.Millisecond))
+}
+
+func Test_EventuallyWithT(t *testing.T) {
+	mockT := &bufferT{}
+
+	var n int32
+	True(t, EventuallyWithT(mockT, func(c *Assertions) {
+		c.Equal(int32(3), atomic.AddInt32(&n, 1))
+	}, 100*time.Millisecond, time.Millisecond))
+
+	var rounds int32
+	False(t, EventuallyWithT(mockT, func(c *Assertions) {
+		c.Equal(-1, int(atomic.AddInt32(&rounds, 1)), "round failed")
+	}, 10*time.Millisecond, time.Millisecond))
+
+	output := mockT.buf.String()
+	Contains(t, output, "Condition is NOT satisfied within 10ms")
+	Contains(t, output, "#1:")
+	Contains(t, output, "round failed")
+}
+
+func Test_EventuallyWithoutLeak(t *testing.T) {
+	mockT := new(testing.T)
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 10; i++ {
+		Eventually(mockT, func() bool {
+			return false
+		}, time.Millisecond, time.Microsecond)
+	}
+
+	// NOTE: the comp of the last tick may be quitting after polling returns
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("Expected at most %d goroutine(s), but got: %d", before, after)
+	}
+}
+
+func Test_EventuallyBlocking(t *testing.T) {
+	mockT := &mockNamedTesting{}
+
+	blocked := make(chan struct{})
+	t.Cleanup(func() {
+		close(blocked)
+	})
+
+	started := time.Now()
+	False(t, Eventually(mockT, func() bool {
+		<-blocked
+
+		return true
+	}, 10*time.Millisecond, time.Millisecond))
+	Less(t, time.Since(started), time.Second)
+	Contains(t, mockT.buf.String(), "Condition is NOT satisfied within 10ms")
+
+	// the comp still running is reported on cleanup
+	mockT.cleanup()
+	Contains(t, mockT.buf.String(), "Condition is still running 1s after the test, it is leaked")
+
+	mockT = &mockNamedTesting{}
+
+	released := make(chan struct{})
+	False(t, Eventually(mockT, func() bool {
+		<-released
+
+		return true
+	}, 10*time.Millisecond, time.Millisecond))
+
+	close(released)
+	mockT.cleanup()
+	NotContains(t, mockT.buf.String(), "Condition is still running")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	False(t, EventuallyWithTContext(ctx, &bufferT{}, func(c *Assertions) {
+		<-blocked
+	}, time.Minute, time.Millisecond))
+
+	// the comp panics within the caller's goroutine
+	defer func() {
+		Equal(t, "Oops~", recover())
+	}()
+
+	Eventually(&bufferT{}, func() bool {
+		panic("Oops~")
+	}, time.Second, time.Millisecond)
+}
+
+func Test_EventuallyTick(t *testing.T) {
+	mockT := &bufferT{}
+
+	// the comp is checked at once, even if the waitFor is less than the tick
+	True(t, Eventually(mockT, func() bool { return true }, time.Millisecond, time.Hour))
+	True(t, EventuallyWithT(mockT, func(c *Assertions) {}, time.Millisecond, time.Hour))
+	False(t, Never(mockT, func() bool { return true }, time.Millisecond, time.Hour))
+
+	bufT := &bufferT{}
+	False(t, Eventually(bufT, func() bool { return true }, time.Second, 0))
+	Contains(t, bufT.buf.String(), "Expected a positive tick, but got 0s")
+
+	bufT = &bufferT{}
+	False(t, Never(bufT, func() bool { return false }, time.Second, -time.Millisecond))
+	Contains(t, bufT.buf.String(), "Expected a positive tick, but got -1ms")
+
+	bufT = &bufferT{}
+	False(t, EventuallyWithT(bufT, func(c *Assertions) {}, time.Second, 0))
+	Contains(t, bufT.buf.String(), "Expected a positive tick, but got 0s")
+}
+
+func TestEventuallyWrapper(t *testing.T) {
+	it := New(new(testing.T))
+
+	True(t, it.Eventually(func() bool { return true }, 10*time.Millisecond, time.Millisecond))
+	True(t, it.Never(func() bool { return false }, 10*time.Millisecond, time.Millisecond))
+	True(t, it.EventuallyWithT(func(c *Assertions) {
+		c.True(true)
+	}, 10*time.Millisecond, time.Millisecond))
+}
@@ -0,0 +1,7 @@
+package assert
+
+// exports for tests of traces in package assert_test.
+var (
+	ResetHelperPackages = resetHelperPackages
+	TracePath           = tracePath
+)
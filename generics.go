@@ -0,0 +1,552 @@
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+
+	"github.com/kr/pretty"
+)
+
+// EqualOf asserts that two values of the same type are equal.
+// Unlike Equal, mismatched types are rejected at compile time.
+//
+//	assert.EqualOf(t, int64(123), v)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EqualOf[T comparable](t Testing, expected, actual T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !AreEqualObjects(expected, actual) {
+		return Fail(t,
+			pretty.Sprintf(
+				"Expected values are NOT equal.%s",
+				diffObjects(diffColorOf(t), expected, actual),
+			),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// NotEqualOf asserts that two values of the same type are NOT equal.
+//
+//	assert.NotEqualOf(t, "foo", v)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotEqualOf[T comparable](t Testing, expected, actual T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if AreEqualObjects(expected, actual) {
+		return Fail(t,
+			pretty.Sprintf("Expected values are NOT equal in value.%s", diffValues(diffColorOf(t), expected, actual)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// ContainsOf asserts that the slice contains the element.
+//
+//	assert.ContainsOf(t, []string{"Hello", "World"}, "World")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ContainsOf[S ~[]E, E comparable](t Testing, list S, v E, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !containsOf(list, v) {
+		return Fail(t,
+			pretty.Sprintf("%#v does not contain `%v`", list, v),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// NotContainsOf asserts that the slice does NOT contain the element.
+//
+//	assert.NotContainsOf(t, []string{"Hello", "World"}, "Earth")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotContainsOf[S ~[]E, E comparable](t Testing, list S, v E, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if containsOf(list, v) {
+		return Fail(t,
+			pretty.Sprintf("%#v contains `%v`", list, v),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// LenOf asserts that the slice has specific length.
+//
+//	assert.LenOf(t, []int{1, 2, 3}, 3)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LenOf[S ~[]E, E any](t Testing, list S, length int, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if n := len(list); n != length {
+		return Fail(t,
+			pretty.Sprintf("Expected %#v should have %d item(s), but got: %d item(s)", list, length, n),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// KeyOf asserts that the map contains the key.
+//
+//	assert.KeyOf(t, map[string]int{"one": 1}, "one")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func KeyOf[M ~map[K]V, K comparable, V any](t Testing, m M, key K, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if _, ok := m[key]; !ok {
+		return Fail(t,
+			pretty.Sprintf("%#v does not contain key `%v`", m, key),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// NotKeyOf asserts that the map does NOT contain the key.
+//
+//	assert.NotKeyOf(t, map[string]int{"one": 1}, "two")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotKeyOf[M ~map[K]V, K comparable, V any](t Testing, m M, key K, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if _, ok := m[key]; ok {
+		return Fail(t,
+			pretty.Sprintf("%#v contains key `%v`", m, key),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// ContainsJSONOf asserts that the JSON contains value of the key, which is decoded into T and compared
+// with expected. Unlike ContainsJSON, nil of T matches JSON null and the type is known even if expected is nil.
+//
+//	assert.ContainsJSONOf(t, `{"ids": [1, 2]}`, "ids", []uint64{1, 2})
+//	assert.ContainsJSONOf[*string](t, `{"name": null}`, "name", nil)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ContainsJSONOf[T any](t Testing, actual, key string, expected T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return containsJSON(t, actual, key, reflect.TypeFor[T](), expected, formatAndArgs...)
+}
+
+// Subject wraps a value of type T for type-safe assertions with *Assertions.
+// Go methods cannot declare type parameters, so the typed helpers hang off
+// the Subject returned by Of rather than *Assertions itself.
+type Subject[T any] struct {
+	it *Assertions
+	v  T
+}
+
+// Of creates a *Subject for asserting v with the Assertions.
+//
+//	it := assert.New(t)
+//	assert.Of(it, resp.StatusCode).Equal(http.StatusOK)
+func Of[T any](it *Assertions, v T) *Subject[T] {
+	return &Subject[T]{
+		it: it,
+		v:  v,
+	}
+}
+
+// Equal asserts that the subject is equal to expected.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *Subject[T]) Equal(expected T, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return s.it.Equal(expected, s.v, formatAndArgs...)
+}
+
+// NotEqual asserts that the subject is NOT equal to expected.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *Subject[T]) NotEqual(expected T, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return s.it.NotEqual(expected, s.v, formatAndArgs...)
+}
+
+// Zero asserts that the subject is the zero value of T.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *Subject[T]) Zero(formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return s.it.Zero(s.v, formatAndArgs...)
+}
+
+// NotZero asserts that the subject is NOT the zero value of T.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *Subject[T]) NotZero(formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return s.it.NotZero(s.v, formatAndArgs...)
+}
+
+// Satisfies asserts that the subject passes the predicate.
+//
+//	assert.Of(it, user).Satisfies(func(u *User) bool { return u.Active })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *Subject[T]) Satisfies(fn func(v T) bool, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !fn(s.v) {
+		return s.it.Fail(
+			pretty.Sprintf("Expected %#v to satisfy %T", s.v, fn),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// SliceSubject wraps a slice for type-safe assertions with *Assertions.
+type SliceSubject[S ~[]E, E comparable] struct {
+	it   *Assertions
+	list S
+}
+
+// SliceOf creates a *SliceSubject for asserting list with the Assertions.
+//
+//	assert.SliceOf(it, names).Contains("World")
+func SliceOf[S ~[]E, E comparable](it *Assertions, list S) *SliceSubject[S, E] {
+	return &SliceSubject[S, E]{
+		it:   it,
+		list: list,
+	}
+}
+
+// Contains asserts that the slice contains the element.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *SliceSubject[S, E]) Contains(v E, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return ContainsOf(s.it.t, s.list, v, formatAndArgs...)
+}
+
+// NotContains asserts that the slice does NOT contain the element.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *SliceSubject[S, E]) NotContains(v E, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return NotContainsOf(s.it.t, s.list, v, formatAndArgs...)
+}
+
+// Len asserts that the slice has specific length.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *SliceSubject[S, E]) Len(length int, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return s.it.Len(s.list, length, formatAndArgs...)
+}
+
+// MapSubject wraps a map for type-safe assertions with *Assertions.
+type MapSubject[M ~map[K]V, K comparable, V any] struct {
+	it *Assertions
+	m  M
+}
+
+// MapOf creates a *MapSubject for asserting m with the Assertions.
+//
+//	assert.MapOf(it, headers).Key("Content-Type")
+func MapOf[M ~map[K]V, K comparable, V any](it *Assertions, m M) *MapSubject[M, K, V] {
+	return &MapSubject[M, K, V]{
+		it: it,
+		m:  m,
+	}
+}
+
+// Key asserts that the map contains the key.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *MapSubject[M, K, V]) Key(key K, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return KeyOf(s.it.t, s.m, key, formatAndArgs...)
+}
+
+// NotKey asserts that the map does NOT contain the key.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *MapSubject[M, K, V]) NotKey(key K, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return NotKeyOf(s.it.t, s.m, key, formatAndArgs...)
+}
+
+// Len asserts that the map has specific length.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *MapSubject[M, K, V]) Len(length int, formatAndArgs ...any) bool {
+	if h, ok := s.it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return s.it.Len(s.m, length, formatAndArgs...)
+}
+
+// containsOf returns true if the list includes the element.
+func containsOf[S ~[]E, E comparable](list S, v E) bool {
+	for _, item := range list {
+		if AreEqualObjects(item, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SameSetOf asserts that both slices have the same set of elements, regardless of order and duplicates.
+//
+//	assert.SameSetOf(t, []string{"foo", "bar"}, []string{"bar", "foo", "bar"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func SameSetOf[S ~[]E, E comparable](t Testing, expected, actual S, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	missing := setDifferenceOf(expected, actual)
+	extra := setDifferenceOf(actual, expected)
+	if len(missing) > 0 || len(extra) > 0 {
+		return failWithContent(t,
+			"Expected the same set of elements, but they are NOT",
+			elementsContent([]string{"Missing", "Extra"}, missing, extra),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// setDifferenceOf returns elements of list not in other with their counts, in order of their first appearances.
+func setDifferenceOf[S ~[]E, E comparable](list, other S) []elementCount {
+	set := make(map[E]struct{}, len(other))
+	for _, v := range other {
+		set[v] = struct{}{}
+	}
+
+	var (
+		counts  []elementCount
+		indexes = map[E]int{}
+	)
+	for _, v := range list {
+		if _, ok := set[v]; ok {
+			continue
+		}
+
+		if i, ok := indexes[v]; ok {
+			counts[i].count++
+			continue
+		}
+
+		indexes[v] = len(counts)
+		counts = append(counts, elementCount{value: v, count: 1})
+	}
+
+	return counts
+}
+
+// assertOrderedOf asserts that ok(cmp.Compare(v, than)) is true, relation is used by the failure.
+func assertOrderedOf[T cmp.Ordered](t Testing, v, than T, relation string, ok func(n int) bool, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !ok(cmp.Compare(v, than)) {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be %s %s", formatOrdered(v), relation, formatOrdered(than)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// GreaterOf asserts that the v is greater than the than of the same type.
+//
+//	assert.GreaterOf(t, len(users), 0)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GreaterOf[T cmp.Ordered](t Testing, v, than T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrderedOf(t, v, than, "greater than", func(n int) bool { return n > 0 }, formatAndArgs...)
+}
+
+// GreaterOrEqualOf asserts that the v is greater than or equal to the than of the same type.
+//
+//	assert.GreaterOrEqualOf(t, user.Age, 18)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GreaterOrEqualOf[T cmp.Ordered](t Testing, v, than T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrderedOf(t, v, than, "greater than or equal to", func(n int) bool { return n >= 0 }, formatAndArgs...)
+}
+
+// LessOf asserts that the v is less than the than of the same type.
+//
+//	assert.LessOf(t, elapsed, time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LessOf[T cmp.Ordered](t Testing, v, than T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrderedOf(t, v, than, "less than", func(n int) bool { return n < 0 }, formatAndArgs...)
+}
+
+// LessOrEqualOf asserts that the v is less than or equal to the than of the same type.
+//
+//	assert.LessOrEqualOf(t, len(page), 20)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LessOrEqualOf[T cmp.Ordered](t Testing, v, than T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrderedOf(t, v, than, "less than or equal to", func(n int) bool { return n <= 0 }, formatAndArgs...)
+}
+
+// BetweenOf asserts that the v is between lo and hi of the same type inclusively.
+//
+//	assert.BetweenOf(t, user.Age, 18, 60)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func BetweenOf[T cmp.Ordered](t Testing, v, lo, hi T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if cmp.Compare(v, lo) < 0 || cmp.Compare(v, hi) > 0 {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be between %s and %s", formatOrdered(v), formatOrdered(lo), formatOrdered(hi)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// PositiveOf asserts that the number is greater than zero, the number is of integer or float types.
+//
+//	assert.PositiveOf(t, balance)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PositiveOf[T Number](t Testing, v T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	// NOTE: NaN is neither positive nor negative
+	var zero T
+	if !(v > zero) {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be positive", formatOrdered(v)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// NegativeOf asserts that the number is less than zero, the number is of integer or float types.
+//
+//	assert.NegativeOf(t, offset)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NegativeOf[T Number](t Testing, v T, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	// NOTE: NaN is neither positive nor negative
+	var zero T
+	if !(v < zero) {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be negative", formatOrdered(v)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// IsSorted asserts that the slice is sorted by less, i.e. no element is less than the previous one.
+//
+//	assert.IsSorted(t, users, func(a, b User) bool { return a.Age < b.Age })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsSorted[S ~[]E, E any](t Testing, list S, less func(a, b E) bool, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	for i := 1; i < len(list); i++ {
+		if less(list[i], list[i-1]) {
+			return Fail(t,
+				fmt.Sprintf("Expected elements to be sorted, but element #%d %s is less than element #%d %s",
+					i, formatValue(reflect.ValueOf(list[i])), i-1, formatValue(reflect.ValueOf(list[i-1]))),
+				formatAndArgs...)
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,130 @@
+package assert
+
+import (
+	"testing"
+)
+
+func Test_EqualOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, EqualOf(mockT, 123, 123))
+	True(t, EqualOf(mockT, "Hello world", "Hello world"))
+	True(t, EqualOf(mockT, int64(123), 123))
+	True(t, EqualOf(mockT, struct{ a string }{"a"}, struct{ a string }{"a"}))
+
+	False(t, EqualOf(mockT, 123, 321))
+	False(t, EqualOf(mockT, "Hello world", "hello world"))
+	False(t, EqualOf(mockT, &struct{}{}, nil))
+}
+
+func Test_NotEqualOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, NotEqualOf(mockT, 123, 321))
+	False(t, NotEqualOf(mockT, "Hello world", "Hello world"))
+}
+
+func Test_ContainsOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	type names []string
+
+	True(t, ContainsOf(mockT, []string{"Hello", "World"}, "World"))
+	True(t, ContainsOf(mockT, names{"Hello", "World"}, "Hello"))
+	False(t, ContainsOf(mockT, []int{1, 2, 3}, 4))
+	False(t, ContainsOf(mockT, []int(nil), 0))
+
+	True(t, NotContainsOf(mockT, []int{1, 2, 3}, 4))
+	False(t, NotContainsOf(mockT, names{"Hello", "World"}, "World"))
+}
+
+func Test_LenOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, LenOf(mockT, []int{1, 2, 3}, 3))
+	True(t, LenOf(mockT, []string(nil), 0))
+	False(t, LenOf(mockT, []int{1, 2, 3}, 2))
+}
+
+func Test_KeyOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	m := map[string]int{"one": 1, "two": 2}
+
+	True(t, KeyOf(mockT, m, "one"))
+	False(t, KeyOf(mockT, m, "three"))
+
+	True(t, NotKeyOf(mockT, m, "three"))
+	False(t, NotKeyOf(mockT, m, "two"))
+}
+
+func Test_ContainsJSONOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	jsonStr := `{"ids": [1, 2], "name": null, "user": {"name": "golib"}}`
+
+	True(t, ContainsJSONOf(mockT, jsonStr, "ids", []uint64{1, 2}))
+	True(t, ContainsJSONOf[*string](mockT, jsonStr, "name", nil))
+	False(t, ContainsJSONOf[map[string]string](&bufferT{}, jsonStr, "user", nil))
+	True(t, ContainsJSONOf(mockT, jsonStr, "$.user", struct {
+		Name string `json:"name"`
+	}{Name: "golib"}))
+
+	bufT := &bufferT{}
+	False(t, ContainsJSONOf[*string](bufT, jsonStr, "user.name", nil))
+	Contains(t, bufT.buf.String(), "Expected contains actual key user.name")
+}
+
+func Test_GenericsFailureOutput(t *testing.T) {
+	mockT := &bufferT{}
+
+	EqualOf(mockT, "want", "got")
+	Contains(t, mockT.buf.String(), "Expected values are NOT equal.")
+	Contains(t, mockT.buf.String(), `-"want"`)
+	Contains(t, mockT.buf.String(), `+"got"`)
+}
+
+func TestOfWrapper(t *testing.T) {
+	it := New(new(testing.T))
+
+	True(t, Of(it, 123).Equal(123))
+	False(t, Of(it, 123).Equal(321))
+	True(t, Of(it, "foo").NotEqual("bar"))
+	True(t, Of(it, 0).Zero())
+	True(t, Of(it, "foo").NotZero())
+	True(t, Of(it, 42).Satisfies(func(v int) bool { return v > 0 }))
+	False(t, Of(it, -42).Satisfies(func(v int) bool { return v > 0 }))
+
+	// options of the Assertions apply to the Subject
+	True(t, Of(New(new(testing.T), WithCompareOptions(EquateApprox(0, 0.1))), 1.0).Equal(1.05))
+
+	mockT := &mockGroupTesting{}
+	False(t, Of(NewRequire(mockT), "want").Equal("got"))
+	True(t, mockT.failedNow)
+
+	mockT = &mockGroupTesting{}
+	False(t, SliceOf(NewRequire(mockT), []int{1}).Contains(2))
+	True(t, mockT.failedNow)
+}
+
+func TestSliceOfWrapper(t *testing.T) {
+	it := New(new(testing.T))
+
+	list := []string{"Hello", "World"}
+
+	True(t, SliceOf(it, list).Contains("World"))
+	True(t, SliceOf(it, list).NotContains("Earth"))
+	True(t, SliceOf(it, list).Len(2))
+	False(t, SliceOf(it, list).Len(3))
+}
+
+func TestMapOfWrapper(t *testing.T) {
+	it := New(new(testing.T))
+
+	m := map[string]int{"one": 1}
+
+	True(t, MapOf(it, m).Key("one"))
+	True(t, MapOf(it, m).NotKey("two"))
+	True(t, MapOf(it, m).Len(1))
+	False(t, MapOf(it, m).Key("two"))
+}
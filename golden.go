@@ -0,0 +1,333 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/kr/pretty"
+)
+
+const (
+	// goldenUpdateFlag is the name of flag which enables rewriting golden files and snapshots,
+	// it's honoured only if the package under testing defines it, i.e.
+	//
+	//	var update = flag.Bool("update", false, "rewrite golden files")
+	goldenUpdateFlag = "update"
+
+	// goldenUpdateEnv is the name of env which enables rewriting golden files and snapshots.
+	goldenUpdateEnv = "GOLIB_ASSERT_UPDATE"
+)
+
+var (
+	// goldenDir is the directory golden files and snapshots stored in,
+	// relative to the package under testing.
+	goldenDir = "testdata"
+
+	// snapshots tracks call order of Snapshot by test name and snapshot files asserted.
+	snapshots = struct {
+		sync.Mutex
+
+		counters map[string]int
+		used     map[string]bool
+	}{
+		counters: map[string]int{},
+		used:     map[string]bool{},
+	}
+
+	invalidSnapshotChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+)
+
+// Golden asserts that the actual is equal to content of the golden file named with name
+// under testdata/. The golden file is rewritten with actual if tests run with GOLIB_ASSERT_UPDATE=1 env,
+// or with -update flag defined by the package under testing.
+//
+// JSON values of string, []byte or json.RawMessage are stored with canonical indentation,
+// other strings and bytes are stored as is, and the rest values are stored in Go syntax.
+//
+//	assert.Golden(t, "user.json", resp.Body.Bytes())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Golden(t Testing, name string, actual any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return matchGolden(t, filepath.Join(goldenDir, name+".golden"), goldenBytes(actual), formatAndArgs...)
+}
+
+// Snapshot asserts that the value is equal to the snapshot stored under testdata/snapshots/.
+// Snapshots are keyed by name of the test and call order of Snapshot within it, thus the Testing
+// must implement Name() string, i.e. *testing.T. Snapshots are rewritten with value in update mode,
+// see Golden for details. Use RunSnapshots in TestMain to report snapshots no longer used.
+//
+//	assert.Snapshot(t, user)
+//	assert.Snapshot(t, `{"name": "golib"}`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Snapshot(t Testing, value any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	n, ok := testingOf(t).(namer)
+	if !ok || n.Name() == "" {
+		return Fail(t,
+			pretty.Sprintf("Snapshot requires %T to implement `Name() string`", testingOf(t)),
+			formatAndArgs...)
+	}
+
+	name := n.Name()
+
+	snapshots.Lock()
+	snapshots.counters[name]++
+	order := snapshots.counters[name]
+	filename := filepath.Join(goldenDir, "snapshots",
+		fmt.Sprintf("%s_%d.snap", invalidSnapshotChars.ReplaceAllString(name, "_"), order))
+	snapshots.used[filename] = true
+	snapshots.Unlock()
+
+	// reset call order for running the test again, i.e. go test -count=2
+	if c, ok := testingOf(t).(cleaner); ok && order == 1 {
+		c.Cleanup(func() {
+			snapshots.Lock()
+			delete(snapshots.counters, name)
+			snapshots.Unlock()
+		})
+	}
+
+	return matchGolden(t, filename, goldenBytes(value), formatAndArgs...)
+}
+
+// UnusedSnapshots returns snapshot files under testdata/snapshots/ which are not asserted
+// by Snapshot within the running tests, see RunSnapshots for reporting them after all tests are run.
+//
+// NOTE: Snapshots of tests skipped, e.g. by -run or -short, are returned too.
+func UnusedSnapshots() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(goldenDir, "snapshots", "*.snap"))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots.Lock()
+	defer snapshots.Unlock()
+
+	var unused []string
+	for _, file := range files {
+		if !snapshots.used[file] {
+			unused = append(unused, file)
+		}
+	}
+	sort.Strings(unused)
+
+	return unused, nil
+}
+
+// RunSnapshots runs tests with m, i.e. *testing.M, and reports snapshot files which are not asserted
+// by Snapshot to stderr, the unused snapshots are removed in update mode. It returns the exit code of
+// m.Run() for os.Exit.
+//
+//	func TestMain(m *testing.M) {
+//	  os.Exit(assert.RunSnapshots(m))
+//	}
+//
+// NOTE: Nothing is reported if any test fails or tests are filtered by -run, -skip or -short,
+// since Snapshot of tests not run are not reached.
+func RunSnapshots(m interface{ Run() int }) int {
+	code := m.Run()
+	if code != 0 || isTestFiltered() {
+		return code
+	}
+
+	if err := reportUnusedSnapshots(os.Stderr, isGoldenUpdate()); err != nil {
+		fmt.Fprintf(os.Stderr, "assert: failed to check unused snapshots: %v\n", err)
+	}
+
+	return code
+}
+
+// reportUnusedSnapshots writes unused snapshots to w, and removes them if remove is true.
+func reportUnusedSnapshots(w io.Writer, remove bool) error {
+	unused, err := UnusedSnapshots()
+	if err != nil || len(unused) == 0 {
+		return err
+	}
+
+	if !remove {
+		fmt.Fprintf(w, "assert: %d snapshot(s) unused, run tests with -%s flag or %s=1 env to remove them:\n",
+			len(unused), goldenUpdateFlag, goldenUpdateEnv)
+		for _, file := range unused {
+			fmt.Fprintf(w, "\t%s\n", file)
+		}
+
+		return nil
+	}
+
+	for _, file := range unused {
+		if err := os.Remove(file); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "assert: removed unused snapshot %s\n", file)
+	}
+
+	return nil
+}
+
+// isTestFiltered returns true if tests run with -run, -skip or -short flags of go test.
+func isTestFiltered() bool {
+	for _, name := range []string{"test.run", "test.skip"} {
+		if f := flag.Lookup(name); f != nil && f.Value.String() != "" {
+			return true
+		}
+	}
+
+	if f := flag.Lookup("test.short"); f != nil {
+		short, _ := strconv.ParseBool(f.Value.String())
+
+		return short
+	}
+
+	return false
+}
+
+// Golden asserts that the actual is equal to content of the golden file named with name under testdata/.
+//
+//	it.Golden("user.json", resp.Body.Bytes())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Golden(name string, actual interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Golden(it.t, name, actual, formatAndArgs...)
+}
+
+// Snapshot asserts that the value is equal to the snapshot stored under testdata/snapshots/.
+//
+//	it.Snapshot(user)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) Snapshot(value interface{}, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Snapshot(it.t, value, formatAndArgs...)
+}
+
+// matchGolden compares data with content of the filename, or rewrites it in update mode.
+func matchGolden(t Testing, filename string, data []byte, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if isGoldenUpdate() {
+		err := os.MkdirAll(filepath.Dir(filename), 0o755)
+		if err == nil {
+			err = os.WriteFile(filename, data, 0o644)
+		}
+		if err != nil {
+			return Fail(t,
+				pretty.Sprintf("Failed to update golden file %s: %v", filename, err),
+				formatAndArgs...)
+		}
+
+		return true
+	}
+
+	expected, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Fail(t,
+				pretty.Sprintf("Golden file %s does not exist, run tests with -%s flag or %s=1 env to create it",
+					filename, goldenUpdateFlag, goldenUpdateEnv),
+				formatAndArgs...)
+		}
+
+		return Fail(t,
+			pretty.Sprintf("Failed to read golden file %s: %v", filename, err),
+			formatAndArgs...)
+	}
+
+	if !bytes.Equal(expected, data) {
+		return Fail(t,
+			pretty.Sprintf("Expected content of golden file %s is NOT equal.%s",
+				filename, diffTexts(diffColorOf(t), string(expected), string(data))),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// isGoldenUpdate returns true if tests run with GOLIB_ASSERT_UPDATE env, or -update flag defined
+// by the package under testing. The flag is looked up lazily, thus it's never registered by assert.
+func isGoldenUpdate() bool {
+	if ok, err := strconv.ParseBool(os.Getenv(goldenUpdateEnv)); err == nil && ok {
+		return true
+	}
+
+	if f := flag.Lookup(goldenUpdateFlag); f != nil {
+		ok, _ := strconv.ParseBool(f.Value.String())
+
+		return ok
+	}
+
+	return false
+}
+
+// goldenBytes serializes v for golden files and snapshots, the result always ends with a line break.
+func goldenBytes(v any) []byte {
+	var data []byte
+
+	switch x := v.(type) {
+	case json.RawMessage:
+		data = canonicalJSON(x)
+	case []byte:
+		data = canonicalJSON(x)
+	case string:
+		data = canonicalJSON([]byte(x))
+	default:
+		data = []byte(pretty.Sprintf("%#v", v))
+	}
+
+	if !bytes.HasSuffix(data, []byte("\n")) {
+		data = append(data, '\n')
+	}
+
+	return data
+}
+
+// canonicalJSON returns data with sorted keys and indentation if it's a valid JSON,
+// otherwise data is returned as is.
+func canonicalJSON(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return data
+	}
+
+	buf := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return data
+	}
+
+	return buf.Bytes()
+}
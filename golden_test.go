@@ -0,0 +1,176 @@
+package assert
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// NOTE: packages under testing define the flag of their own, which must NOT be redefined by assert.
+var update = flag.Bool("update", false, "rewrite golden files")
+
+type mockNamedTesting struct {
+	bufferT
+
+	name     string
+	cleanups []func()
+}
+
+func (m *mockNamedTesting) Name() string {
+	return m.name
+}
+
+func (m *mockNamedTesting) Cleanup(fn func()) {
+	m.cleanups = append(m.cleanups, fn)
+}
+
+func (m *mockNamedTesting) cleanup() {
+	for i := len(m.cleanups) - 1; i >= 0; i-- {
+		m.cleanups[i]()
+	}
+	m.cleanups = nil
+}
+
+func withGoldenDir(t *testing.T) string {
+	dir := goldenDir
+	t.Cleanup(func() {
+		goldenDir = dir
+	})
+
+	goldenDir = t.TempDir()
+
+	return goldenDir
+}
+
+func Test_Golden(t *testing.T) {
+	dir := withGoldenDir(t)
+	mockT := &bufferT{}
+
+	False(t, Golden(mockT, "user", `{"name": "golib", "age": 1}`))
+	Contains(t, mockT.buf.String(), "does not exist, run tests with -update flag or GOLIB_ASSERT_UPDATE=1 env")
+
+	t.Setenv(goldenUpdateEnv, "1")
+	True(t, Golden(mockT, "user", `{"name": "golib", "age": 1}`))
+
+	data, err := os.ReadFile(filepath.Join(dir, "user.golden"))
+	if NotError(t, err) {
+		Equal(t, "{\n  \"age\": 1,\n  \"name\": \"golib\"\n}\n", string(data))
+	}
+
+	t.Setenv(goldenUpdateEnv, "")
+	mockT = &bufferT{}
+	True(t, Golden(mockT, "user", []byte(`{"age":1,"name":"golib"}`)))
+	False(t, Golden(mockT, "user", `{"name": "golib", "age": 2}`))
+	Contains(t, mockT.buf.String(), "Expected content of golden file")
+	Contains(t, mockT.buf.String(), `-  "age": 1,`)
+	Contains(t, mockT.buf.String(), `+  "age": 2,`)
+}
+
+func Test_GoldenWithValue(t *testing.T) {
+	withGoldenDir(t)
+	t.Setenv(goldenUpdateEnv, "1")
+
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	mockT := &bufferT{}
+	True(t, Golden(mockT, "value", &user{Name: "golib", Age: 1}))
+	True(t, Golden(mockT, "text", "plain text"))
+
+	t.Setenv(goldenUpdateEnv, "0")
+	True(t, Golden(mockT, "value", &user{Name: "golib", Age: 1}))
+	True(t, Golden(mockT, "text", []byte("plain text\n")))
+	False(t, Golden(mockT, "value", &user{Name: "golib", Age: 2}))
+}
+
+func Test_Snapshot(t *testing.T) {
+	dir := withGoldenDir(t)
+
+	False(t, Snapshot(&bufferT{}, "no name"))
+
+	t.Setenv(goldenUpdateEnv, "1")
+	mockT := &mockNamedTesting{name: "TestUser/with spaces"}
+	True(t, Snapshot(mockT, "first"))
+	True(t, Snapshot(mockT, 2))
+	mockT.cleanup()
+
+	_, err := os.Stat(filepath.Join(dir, "snapshots", "TestUser_with_spaces_1.snap"))
+	NotError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "snapshots", "TestUser_with_spaces_2.snap"))
+	NotError(t, err)
+
+	t.Setenv(goldenUpdateEnv, "")
+	True(t, Snapshot(mockT, "first"))
+	False(t, Snapshot(mockT, 3))
+	mockT.cleanup()
+
+	err = os.WriteFile(filepath.Join(dir, "snapshots", "TestRemoved_1.snap"), []byte("removed\n"), 0o644)
+	if NotError(t, err) {
+		unused, err := UnusedSnapshots()
+		NotError(t, err)
+		Equal(t, []string{filepath.Join(dir, "snapshots", "TestRemoved_1.snap")}, unused)
+
+		buf := new(bytes.Buffer)
+		NotError(t, reportUnusedSnapshots(buf, false))
+		Equal(t, "assert: 1 snapshot(s) unused, run tests with -update flag or GOLIB_ASSERT_UPDATE=1 env to remove them:\n"+
+			"\t"+filepath.Join(dir, "snapshots", "TestRemoved_1.snap")+"\n", buf.String())
+
+		buf.Reset()
+		NotError(t, reportUnusedSnapshots(buf, true))
+		Contains(t, buf.String(), "assert: removed unused snapshot ")
+
+		_, err = os.Stat(filepath.Join(dir, "snapshots", "TestRemoved_1.snap"))
+		True(t, os.IsNotExist(err))
+	}
+}
+
+type mockTestMain int
+
+func (m mockTestMain) Run() int {
+	return int(m)
+}
+
+func Test_RunSnapshots(t *testing.T) {
+	dir := withGoldenDir(t)
+
+	err := os.MkdirAll(filepath.Join(dir, "snapshots"), 0o755)
+	if NotError(t, err) {
+		err = os.WriteFile(filepath.Join(dir, "snapshots", "TestRemoved_1.snap"), []byte("removed\n"), 0o644)
+		NotError(t, err)
+	}
+
+	// NOTE: unused snapshots are NOT reported nor removed for failed tests
+	t.Setenv(goldenUpdateEnv, "1")
+	Equal(t, 1, RunSnapshots(mockTestMain(1)))
+
+	_, err = os.Stat(filepath.Join(dir, "snapshots", "TestRemoved_1.snap"))
+	NotError(t, err)
+}
+
+func Test_isGoldenUpdate(t *testing.T) {
+	t.Setenv(goldenUpdateEnv, "")
+	False(t, isGoldenUpdate())
+
+	t.Setenv(goldenUpdateEnv, "1")
+	True(t, isGoldenUpdate())
+
+	t.Setenv(goldenUpdateEnv, "")
+	NotError(t, flag.Set("update", "true"))
+	t.Cleanup(func() {
+		*update = false
+	})
+	True(t, isGoldenUpdate())
+}
+
+func TestGoldenWrapper(t *testing.T) {
+	withGoldenDir(t)
+	t.Setenv(goldenUpdateEnv, "1")
+
+	it := New(t)
+	True(t, it.Golden("wrapper", "golden"))
+	True(t, it.Snapshot("snapshot"))
+}
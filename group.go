@@ -0,0 +1,111 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errFailNowInGroup is used to abort a group when FailNow is called inside it.
+var errFailNowInGroup = errors.New("assert: FailNow called inside group")
+
+// collectT implements Testing by collecting failures instead of reporting them.
+type collectT struct {
+	failures  [][]labeledContent
+	failedNow bool
+}
+
+// Errorf collects failures reported without labeledContent, i.e. custom assertions.
+func (c *collectT) Errorf(format string, args ...interface{}) {
+	c.collectFailure([]labeledContent{
+		{"Error", strings.TrimSpace(fmt.Sprintf(format, args...))},
+	})
+}
+
+// FailNow aborts the running group, the failures collected so far are kept.
+func (c *collectT) FailNow() {
+	c.failedNow = true
+
+	panic(errFailNowInGroup)
+}
+
+func (c *collectT) collectFailure(content []labeledContent) {
+	c.failures = append(c.failures, content)
+}
+
+// run calls fn with a soft *Assertions of the settings, and recovers from FailNow called inside.
+func (c *collectT) run(fn func(g *Assertions), s *settings) {
+	defer func() {
+		if err := recover(); err != nil && err != errFailNowInGroup {
+			panic(err)
+		}
+	}()
+
+	// NOTE: checks inside are soft, the caller quits after reporting in fail fast mode
+	if s != nil && s.fast {
+		soft := *s
+		soft.fast = false
+
+		s = &soft
+	}
+
+	fn(&Assertions{t: withSettings(c, s), settings: s})
+}
+
+// report returns labeledContent of numbered failures collected.
+func (c *collectT) report() []labeledContent {
+	content := make([]labeledContent, 0, len(c.failures))
+	for i, failure := range c.failures {
+		content = append(content, labeledContent{
+			label:   fmt.Sprintf("#%d", i+1),
+			content: labeledText(failure...),
+		})
+	}
+
+	return content
+}
+
+// Group runs fn with a soft *Assertions, which collects every failed check
+// inside the fn and reports them once as a combined failure.
+//
+//	assert.Group(t, "user payload", func(g *assert.Assertions) {
+//	  g.Equal("foo", user.Name)
+//	  g.Equal(18, user.Age)
+//	})
+//
+// Returns whether all checks inside the group were successful (true) or not (false).
+func Group(t Testing, name string, fn func(g *Assertions), formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	c := new(collectT)
+	c.run(fn, settingsOf(t))
+
+	if len(c.failures) == 0 {
+		return true
+	}
+
+	return failWithContent(t,
+		fmt.Sprintf("Group %q has %d failure(s)", name, len(c.failures)),
+		c.report(),
+		formatAndArgs...)
+}
+
+// Group runs fn with a soft *Assertions, which collects every failed check
+// inside the fn and reports them once as a combined failure. It calls FailNow
+// after reporting if the Assertions is in fail fast mode.
+//
+//	it.Group("user payload", func(g *assert.Assertions) {
+//	  g.Equal("foo", user.Name)
+//	  g.Equal(18, user.Age)
+//	})
+//
+// Returns whether all checks inside the group were successful (true) or not (false).
+func (it *Assertions) Group(name string, fn func(g *Assertions), formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return Group(it.t, name, fn, formatAndArgs...)
+}
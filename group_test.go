@@ -0,0 +1,104 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_Group(t *testing.T) {
+	mockT := &bufferT{}
+
+	ok := Group(mockT, "user payload", func(g *Assertions) {
+		g.Equal("foo", "foo")
+		g.Equal("foo", "bar")
+		g.Len([]int{1, 2}, 3)
+		g.True(true)
+	})
+	False(t, ok)
+
+	output := mockT.buf.String()
+	Equal(t, 1, strings.Count(output, `Group "user payload" has 2 failure(s)`))
+	Contains(t, output, "#1:")
+	Contains(t, output, "Expected values are NOT equal.")
+	Contains(t, output, "#2:")
+	Contains(t, output, "should have 3 item(s), but got: 2 item(s)")
+	NotContains(t, output, "#3:")
+}
+
+func Test_GroupWithoutFailure(t *testing.T) {
+	mockT := &bufferT{}
+
+	True(t, Group(mockT, "empty", func(g *Assertions) {
+		g.Equal("foo", "foo")
+	}))
+	Empty(t, mockT.buf.String())
+}
+
+func Test_GroupWithFailNow(t *testing.T) {
+	mockT := &bufferT{}
+
+	ok := Group(mockT, "fail now", func(g *Assertions) {
+		g.FailNow("stop here")
+		g.Fail("never reached")
+	})
+	False(t, ok)
+
+	output := mockT.buf.String()
+	Contains(t, output, "stop here")
+	NotContains(t, output, "never reached")
+}
+
+func Test_GroupNested(t *testing.T) {
+	mockT := &bufferT{}
+
+	ok := Group(mockT, "outer", func(g *Assertions) {
+		g.Group("inner", func(g *Assertions) {
+			g.Equal(1, 2)
+		})
+	})
+	False(t, ok)
+
+	output := mockT.buf.String()
+	Contains(t, output, `Group "outer" has 1 failure(s)`)
+	Contains(t, output, `Group "inner" has 1 failure(s)`)
+}
+
+type mockGroupTesting struct {
+	bufferT
+
+	failedNow bool
+}
+
+func (m *mockGroupTesting) FailNow() {
+	m.failedNow = true
+}
+
+func TestGroupWrapper(t *testing.T) {
+	mockT := &mockGroupTesting{}
+
+	it := New(mockT)
+	False(t, it.Group("soft", func(g *Assertions) {
+		g.Equal(1, 2)
+	}))
+	False(t, mockT.failedNow)
+
+	it = NewRequire(mockT)
+	False(t, it.Group("require", func(g *Assertions) {
+		g.Equal(1, 2)
+		g.Equal(3, 4)
+	}))
+	True(t, mockT.failedNow)
+	Contains(t, mockT.buf.String(), `Group "require" has 2 failure(s)`)
+
+	// every assertion quits in fail fast mode, checks inside a group are soft
+	mockT = &mockGroupTesting{}
+	False(t, NewRequire(mockT).Equal(1, 2))
+	True(t, mockT.failedNow)
+
+	mockT = &mockGroupTesting{}
+	NewRequire(mockT).Group("require", func(g *Assertions) {
+		g.Equal(1, 2)
+		False(t, mockT.failedNow)
+	})
+	True(t, mockT.failedNow)
+}
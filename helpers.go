@@ -3,9 +3,10 @@ package assert
 import (
 	"bufio"
 	"bytes"
+	"encoding"
 	"encoding/json"
 	"fmt"
-	"os"
+	"path"
 	"reflect"
 	"regexp"
 	"runtime"
@@ -15,12 +16,13 @@ import (
 	"unicode/utf8"
 
 	"github.com/buger/jsonparser"
-	"github.com/dolab/colorize"
 	"github.com/kr/pretty"
 	"github.com/pmezard/go-difflib/difflib"
 )
 
 // AreEqualObjects determines if two objects are considered equal.
+// Values with Equal(T) bool or Cmp(T) int method, i.e. time.Time, net.IP and *big.Int,
+// are compared by the method at any depth.
 //
 // NOTE: This func does no assertion of any kind.
 func AreEqualObjects(expected, actual interface{}) bool {
@@ -32,7 +34,7 @@ func AreEqualObjects(expected, actual interface{}) bool {
 		return expected == actual
 	}
 
-	return reflect.DeepEqual(expected, actual)
+	return areEqualWith(expected, actual)
 }
 
 // AreEqualValues gets whether two objects are equal, or if their
@@ -67,120 +69,133 @@ func AreEqualValues(expected, actual interface{}) bool {
 	return false
 }
 
-// StackTraces is necessary because the assert func use the testing object
-// internally, causing it to print the file:line of the assert method,
-// rather than where the problem actually occurred in calling code.
-//
-// StackTraces returns an array of strings containing the file and line number
-// of each stack frame leading from the current test to the assert call that
-// failed.
-func StackTraces() []string {
-	var (
-		pc   uintptr
-		file string
-		name string
-		line int
-		ok   bool
-	)
+// FailNow fails test case and quit, or panic if Testing doesn't implement FailNow.
+func FailNow(t Testing, message string, formatAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
-	var callers []string
-	for i := 0; ; i++ {
-		pc, file, line, ok = runtime.Caller(i)
+	Fail(t, message, formatAndArgs...)
 
-		// The breaks below failed to terminate the loop, and we ran off the
-		// end of the call stack.
-		if !ok {
-			break
-		}
+	// the Testing has quit by Fail in fail fast mode
+	if isFailFast(t) {
+		return false
+	}
 
-		// This is a huge edge case, but it will panic if this is the case.
-		if file == "<autogenerated>" {
-			break
-		}
+	return failNow(t)
+}
 
-		f := runtime.FuncForPC(pc)
-		if f == nil {
-			break
-		}
+// failNow quits test case, or panic if Testing doesn't implement FailNow.
+func failNow(t Testing) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
-		name = f.Name()
+	// We cannot extend Testing with FailNow() and
+	// maintain backwards compatibility, so we fall back
+	// to panicking when FailNow is not available in Testing.
+	// See issue #263
+	if nower, ok := testingOf(t).(failNower); ok {
+		nower.FailNow()
+	} else {
+		panic(fmt.Sprintf("test failed and %T does not implement `FailNow()`", testingOf(t)))
+	}
 
-		// testing.tRunner is the standard library function that calls
-		// tests. Subtests are called directly by tRunner, without going through
-		// the Test/Benchmark/Example function that contains the t.Run calls, so
-		// with subtests we should break when we hit tRunner, without adding it
-		// to the list of callers.
-		if name == "testing.tRunner" {
-			break
-		}
+	return false
+}
 
-		// ignore github.com/golib/assert itself
-		if strings.HasPrefix(name, "github.com/golib/assert.") {
-			continue
-		}
+// Fail reports a failure through
+func Fail(t Testing, message string, formatAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
-		// ignore golang packages
-		root, _ := os.Getwd()
-		paths := strings.Split(strings.TrimPrefix(file, root), "/")
-		if len(paths) < 2 {
-			continue
-		}
+	return report(t, failureContent(t, message, nil, formatAndArgs...), failureValues{})
+}
 
-		if len(paths) > 2 {
-			callers = append(callers, fmt.Sprintf("%s:%d", strings.Join(paths[len(paths)-2:], "/"), line))
-		} else {
-			callers = append(callers, fmt.Sprintf("%s:%d", paths[len(paths)-1], line))
-		}
-		// callers = append(callers, fmt.Sprintf("%s:%d", file, line))
+// failWithContent is the same as Fail, except it appends extra labeled content following the error.
+func failWithContent(t Testing, message string, extras []labeledContent, formatAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
-		// Drop the package
-		segments := strings.Split(name, ".")
-		name = segments[len(segments)-1]
-		if isTest(name, "Test") ||
-			isTest(name, "Benchmark") ||
-			isTest(name, "Example") {
-			break
-		}
+	return report(t, failureContent(t, message, extras, formatAndArgs...), failureValues{})
+}
+
+// failWithValues is the same as Fail, except it reports the values compared to the Reporter.
+func failWithValues(t Testing, message string, values failureValues, formatAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
 	}
 
-	if len(callers) > 1 {
-		return callers[:1]
+	return report(t, failureContent(t, message, nil, formatAndArgs...), values)
+}
+
+// failWithDiff is the same as failWithValues, except the diff of both values is appended to the message.
+func failWithDiff(t Testing, message string, expected, actual any, formatAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
 	}
 
-	return callers
+	diff := diffObjects(diffColorOf(t), expected, actual)
+
+	return failWithValues(t, message+diff, newFailureValues(expected, actual, diff), formatAndArgs...)
 }
 
-// FailNow fails test case and quit, or panic if Testing doesn't implement FailNow.
-func FailNow(t Testing, message string, formatAndArgs ...interface{}) bool {
-	Fail(t, message, formatAndArgs...)
+// report reports labeled content of a failure, which is collected by the group, or reported by
+// the Reporter or Errorf of the Testing, and quits the test in fail fast mode. It always returns false.
+func report(t Testing, content []labeledContent, values failureValues) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 
-	// We cannot extend Testing with FailNow() and
-	// maintain backwards compatibility, so we fall back
-	// to panicking when FailNow is not available in Testing.
-	// See issue #263
-	if nower, ok := t.(failNower); ok {
-		nower.FailNow()
+	// failures inside a group are reported by the group itself
+	if collector, ok := testingOf(t).(failureCollector); ok {
+		collector.collectFailure(content)
+
+		return false
+	}
+
+	if reporter := reporterOf(t); reporter != nil {
+		reporter.Report(t, newFailure(t, content, values))
 	} else {
-		panic(fmt.Sprintf("test failed and %T does not implement `FailNow()`", t))
+		reportText(t, content)
+	}
+
+	if isFailFast(t) {
+		return failNow(t)
 	}
 
 	return false
 }
 
-// Fail reports a failure through
-func Fail(t Testing, message string, formatAndArgs ...interface{}) bool {
+// reportText reports labeled content through Errorf of the Testing, which is the default output.
+func reportText(t Testing, content []labeledContent) {
+	// Testing reports the caller of assertion natively, so the output needs no hacks
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+
+		t.Errorf("\n%s", helperOutput(colorOf(t), content...))
+
+		return
+	}
+
+	t.Errorf("\r" + getWhitespaceString() + labeledOutput(colorOf(t), content...) + "\n")
+}
+
+// failureContent returns labeledContent of a failure with trace, error, extras and messages.
+func failureContent(t Testing, message string, extras []labeledContent, formatAndArgs ...interface{}) []labeledContent {
 	content := []labeledContent{
-		{"Trace", strings.Join(StackTraces(), "\n\r\t\t\t")},
+		{"Trace", strings.Join(stackTraces(traceOf(t)), "\n\r\t\t\t")},
 		{"Error", message},
 	}
+	content = append(content, extras...)
 
-	if extras := formatExtraArgs(formatAndArgs...); len(extras) > 0 {
-		content = append(content, labeledContent{"Messages", extras})
+	if messages := formatExtraArgs(formatAndArgs...); len(messages) > 0 {
+		content = append(content, labeledContent{"Messages", messages})
 	}
 
-	t.Errorf("\r" + getWhitespaceString() + labeledOutput(content...) + "\n")
-
-	return false
+	return content
 }
 
 func formatExtraArgs(formatAndArgs ...interface{}) string {
@@ -248,7 +263,8 @@ type labeledContent struct {
 // alignment is achieved, "\t{{content}}\n" is added for the output.
 //
 // If the content of the labeledOutput contains line breaks, the subsequent lines are aligned so that they start at the same location as the first line.
-func labeledOutput(content ...labeledContent) string {
+// Labels are painted with the colorPolicy, diffs of contents are painted by diffColorize already.
+func labeledOutput(policy colorPolicy, content ...labeledContent) string {
 	longestLabel := 0
 	for _, v := range content {
 		if len(v.label) > longestLabel {
@@ -258,8 +274,8 @@ func labeledOutput(content ...labeledContent) string {
 
 	var output string
 	for _, v := range content {
-		output += fmt.Sprintf("\r\t%s:%s\t%s\n",
-			v.label,
+		output += fmt.Sprintf("\r\t%s%s\t%s\n",
+			policy.label(v.label+":"),
 			strings.Repeat(" ", longestLabel-len(v.label)),
 			paddingLines(v.content, longestLabel),
 		)
@@ -268,18 +284,67 @@ func labeledOutput(content ...labeledContent) string {
 	return output
 }
 
+// helperOutput returns labeledOutput without carriage returns, which is used when the
+// Testing reports the caller of assertion natively.
+func helperOutput(policy colorPolicy, content ...labeledContent) string {
+	return strings.ReplaceAll(labeledOutput(policy, content...), "\r", "")
+}
+
+// labeledText returns a plain string consisting of the provided labeledContent,
+// which is suitable for nesting inside the content of labeledOutput.
+// Each labeled text is appended in the following manner:
+//
+//	{{label}}:{{align_spaces}} {{content}}\n
+//
+// If the content contains line breaks, the subsequent lines are aligned with the first line.
+func labeledText(content ...labeledContent) string {
+	longestLabel := 0
+	for _, v := range content {
+		if len(v.label) > longestLabel {
+			longestLabel = len(v.label)
+		}
+	}
+
+	out := new(bytes.Buffer)
+	for _, v := range content {
+		out.WriteString(v.label + ":" + strings.Repeat(" ", longestLabel-len(v.label)))
+
+		for i, scanner := 0, bufio.NewScanner(strings.NewReader(v.content)); scanner.Scan(); i++ {
+			if i != 0 {
+				out.WriteString("\n" + strings.Repeat(" ", longestLabel+1))
+			}
+
+			// drop the alignment used by multi-line traces of labeledOutput
+			line := strings.TrimPrefix(scanner.Text(), "\r\t\t\t")
+
+			out.WriteString(" " + strings.TrimLeft(line, "\r"))
+		}
+
+		out.WriteString("\n")
+	}
+
+	return out.String()
+}
+
 // getWhitespaceString returns a string that is long enough to overwrite the default
 // output from the go testing framework.
 func getWhitespaceString() string {
-	_, file, line, ok := runtime.Caller(3)
-	if !ok {
-		return ""
-	}
+	// the caller of assertion is the first frame outside sources of the package
+	_, source, _, _ := runtime.Caller(0)
+	dir := path.Dir(source)
 
-	parts := strings.Split(file, "/")
-	file = parts[len(parts)-1]
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if path.Dir(frame.File) != dir || strings.HasSuffix(frame.File, "_test.go") {
+			return strings.Repeat(" ", len(fmt.Sprintf("%s:%d:    ", path.Base(frame.File), frame.Line)))
+		}
 
-	return strings.Repeat(" ", len(fmt.Sprintf("%s:%d:    ", file, line)))
+		if !more {
+			return ""
+		}
+	}
 }
 
 // Aligns the provided message so that all lines after the first line start at the same location as the first line.
@@ -349,16 +414,77 @@ func getLen(v interface{}) (n int, ok bool) {
 	return reflect.ValueOf(v).Len(), true
 }
 
+// getJsonValue returns value of the key, which is dotted keys with numeric subscripts (foo.1),
+// JSON Pointer (/foo/1) or JSONPath ($.foo[1]). String value is returned without quotes.
 func getJsonValue(jsonStr, jsonKey string) ([]byte, error) {
+	if isJSONQuery(jsonKey) {
+		data, err := getJSONQueryValue(jsonStr, jsonKey)
+		if !isJSONPointerNotFound(jsonKey, err) {
+			return data, err
+		}
+
+		if data, _, e := lookupJsonValue(jsonStr, jsonKey); e == nil {
+			return data, nil
+		}
+
+		return nil, err
+	}
+
+	data, _, err := lookupJsonValue(jsonStr, jsonKey)
+
+	return data, err
+}
+
+// getJsonRawValue returns raw JSON of the key in the form of getJsonValue, string value is returned with quotes.
+func getJsonRawValue(jsonStr, jsonKey string) ([]byte, error) {
+	if isJSONQuery(jsonKey) {
+		nodes, err := queryJSON(jsonStr, jsonKey)
+		if isJSONPointerNotFound(jsonKey, err) {
+			if data, e := getJsonDottedRawValue(jsonStr, jsonKey); e == nil {
+				return data, nil
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(nodes) != 1 {
+			return nil, fmt.Errorf("json path %q matches %d values, expected exactly one", jsonKey, len(nodes))
+		}
+
+		return json.Marshal(nodes[0].value)
+	}
+
+	return getJsonDottedRawValue(jsonStr, jsonKey)
+}
+
+// getJsonDottedRawValue returns raw JSON of the dotted keys with numeric subscripts.
+func getJsonDottedRawValue(jsonStr, jsonKey string) ([]byte, error) {
+	data, dataType, err := lookupJsonValue(jsonStr, jsonKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: jsonparser strips quotes of string without unescaping it
+	if dataType == jsonparser.String {
+		data = append(append([]byte{'"'}, data...), '"')
+	}
+
+	return data, nil
+}
+
+// lookupJsonValue returns value and type of the dotted keys with numeric subscripts.
+func lookupJsonValue(jsonStr, jsonKey string) ([]byte, jsonparser.ValueType, error) {
 	var (
-		buf  = []byte(jsonStr)
-		data []byte
-		err  error
+		buf      = []byte(jsonStr)
+		data     []byte
+		dataType jsonparser.ValueType
+		err      error
 	)
 
 	for {
 		// first, try with the raw key
-		data, _, _, err = jsonparser.Get(buf, jsonKey)
+		data, dataType, _, err = jsonparser.Get(buf, jsonKey)
 		if err == nil {
 			buf = data
 			break
@@ -369,7 +495,7 @@ func getJsonValue(jsonStr, jsonKey string) ([]byte, error) {
 
 		yek := parts[0]
 
-		data, _, _, err = jsonparser.Get(buf, yek)
+		data, dataType, _, err = jsonparser.Get(buf, yek)
 		if err == nil {
 			buf = data
 			if len(parts) != 2 {
@@ -391,6 +517,7 @@ func getJsonValue(jsonStr, jsonKey string) ([]byte, error) {
 		_, err = jsonparser.ArrayEach(buf, func(arrBuf []byte, arrType jsonparser.ValueType, arrOffset int, arrErr error) {
 			if i == n {
 				data = arrBuf
+				dataType = arrType
 				buf = data
 				err = arrErr
 			}
@@ -407,31 +534,217 @@ func getJsonValue(jsonStr, jsonKey string) ([]byte, error) {
 		jsonKey = parts[1]
 	}
 	if err != nil {
-		return nil, err
+		return nil, jsonparser.NotExist, err
 	}
 
-	return data, nil
+	return data, dataType, nil
 }
 
-func isJsonEqualObject(data string, obj interface{}) bool {
-	// first, reform json string
-	var value interface{}
-	if err := json.Unmarshal([]byte(data), &value); err != nil {
-		return false
+var (
+	jsonNumberType      = reflect.TypeFor[json.Number]()
+	jsonRawMessageType  = reflect.TypeFor[json.RawMessage]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// jsonKindOf returns kind of the raw JSON, which is one of string, number, boolean, null, array and object.
+func jsonKindOf(data []byte) string {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return "empty"
 	}
 
-	jsonValue, err := json.Marshal(value)
-	if err != nil {
+	switch data[0] {
+	case '"':
+		return "string"
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	}
+
+	return "number"
+}
+
+// jsonKindFor returns kind of JSON which can be decoded into the type,
+// it is empty if the type decodes itself or accepts any kind.
+func jsonKindFor(typ reflect.Type) string {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Implements(jsonUnmarshalerType) || reflect.PointerTo(typ).Implements(jsonUnmarshalerType) ||
+		typ.Implements(textUnmarshalerType) || reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		return ""
+	}
+
+	if typ == jsonNumberType {
+		return "number"
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return "boolean"
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return "number"
+
+	case reflect.String:
+		return "string"
+
+	case reflect.Slice:
+		// NOTE: []byte is decoded from base64 string
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return "string"
+		}
+
+		return "array"
+
+	case reflect.Array:
+		return "array"
+
+	case reflect.Map, reflect.Struct:
+		return "object"
+	}
+
+	return ""
+}
+
+// hasJSONInterfaces returns whether values of the type decoded from JSON hold interface values.
+func hasJSONInterfaces(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
 		return false
 	}
+	visited[typ] = true
 
-	// second, marshal obj with json
-	objValue, err := json.Marshal(obj)
-	if err != nil {
+	if typ.Kind() != reflect.Interface && (reflect.PointerTo(typ).Implements(jsonUnmarshalerType) ||
+		reflect.PointerTo(typ).Implements(textUnmarshalerType)) {
 		return false
 	}
 
-	return bytes.Equal(jsonValue, objValue)
+	switch typ.Kind() {
+	case reflect.Interface:
+		return true
+
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return hasJSONInterfaces(typ.Elem(), visited)
+
+	case reflect.Map:
+		return hasJSONInterfaces(typ.Elem(), visited)
+
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if field := typ.Field(i); field.IsExported() && hasJSONInterfaces(field.Type, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// withJSONArticle returns the kind of JSON with indefinite article, i.e. a string, an object.
+func withJSONArticle(kind string) string {
+	switch kind {
+	case "null", "empty":
+		return kind
+	case "array", "object":
+		return "an " + kind
+	}
+
+	return "a " + kind
+}
+
+// matchJsonValue decodes raw JSON of the key into the type and compares it with expected by AreEqualObjects.
+// It returns a message of the mismatch with diff painted with the colorPolicy, which is empty if they are equal.
+//
+// JSON null matches nil of any type only, and []byte is compared with the text of string or raw JSON of others.
+// Types holding interface values, i.e. map[string]any, are compared as JSON with numbers by value.
+func matchJsonValue(policy colorPolicy, key string, data []byte, typ reflect.Type, expected any) string {
+	kind := jsonKindOf(data)
+
+	if typ == nil || isNil(expected) {
+		if kind == "null" {
+			return ""
+		}
+
+		if typ == nil {
+			return fmt.Sprintf("key %s is %s, expected null", key, withJSONArticle(kind))
+		}
+	}
+
+	switch typ {
+	case jsonRawMessageType:
+		m := &jsonMatcher{
+			opts: jsonOptions{strict: true, numbersByValue: true},
+		}
+
+		expectedValue, err := decodeJSON(string(expected.(json.RawMessage)))
+		if err != nil {
+			return fmt.Sprintf("Expected value ('%s') of key %s is not valid json.\nJSON parsing error: '%s'", expected, key, err.Error())
+		}
+
+		actualValue, _ := decodeJSON(string(data))
+
+		m.match("", expectedValue, actualValue)
+		if len(m.diffs) > 0 {
+			return fmt.Sprintf("Expected key %s of json %s, but got: %s\n%s", key, expected, data, strings.Join(m.diffs, "\n"))
+		}
+
+		return ""
+
+	case reflect.TypeFor[[]byte]():
+		text := data
+		if kind == "string" {
+			var s string
+			_ = json.Unmarshal(data, &s)
+
+			text = []byte(s)
+		}
+
+		if !bytes.Equal(expected.([]byte), text) {
+			return fmt.Sprintf("Expected key %s of bytes %s, but got: %s", key, expected, text)
+		}
+
+		return ""
+	}
+
+	if expectedKind := jsonKindFor(typ); kind == "null" || (expectedKind != "" && expectedKind != kind) {
+		if expectedKind == "" {
+			expectedKind = typ.String()
+		}
+
+		return fmt.Sprintf("key %s is %s, expected %s", key, withJSONArticle(kind), expectedKind)
+	}
+
+	// NOTE: numbers are decoded into interface values as float64, i.e. of map[string]any,
+	// so the expected is compared as raw JSON with numbers by value instead.
+	if hasJSONInterfaces(typ, map[reflect.Type]bool{}) {
+		text, err := json.Marshal(expected)
+		if err != nil {
+			return fmt.Sprintf("Expected value of key %s cannot be encoded as json: %v", key, err)
+		}
+
+		return matchJsonValue(policy, key, data, jsonRawMessageType, json.RawMessage(text))
+	}
+
+	actual := reflect.New(typ)
+	if err := json.Unmarshal(data, actual.Interface()); err != nil {
+		return fmt.Sprintf("key %s of %s cannot be decoded into %s: %v", key, data, typ, err)
+	}
+
+	if !areEqualWith(expected, actual.Elem().Interface()) {
+		return pretty.Sprintf("Expected contains actual key %s of value %v, but got: %s%s",
+			key, expected, data, diffObjects(policy, expected, actual.Elem().Interface()))
+	}
+
+	return ""
 }
 
 // containsElement try loop over the list check if the list includes the element.
@@ -505,11 +818,117 @@ func containsElement(actual, expect interface{}) (ok, found bool) {
 	return true, false
 }
 
+// unwrapErrors returns errors wrapped by err directly, i.e. result of
+// Unwrap() error or Unwrap() []error.
+func unwrapErrors(err error) []error {
+	switch x := err.(type) {
+	case interface{ Unwrap() []error }:
+		var errs []error
+		for _, e := range x.Unwrap() {
+			if e != nil {
+				errs = append(errs, e)
+			}
+		}
+
+		return errs
+
+	case interface{ Unwrap() error }:
+		if e := x.Unwrap(); e != nil {
+			return []error{e}
+		}
+	}
+
+	return nil
+}
+
+// maxErrorDepth limits walking through errors which unwrap themselves endlessly.
+const maxErrorDepth = 64
+
+// walkErrors calls fn for err and each error of its tree in depth first order.
+func walkErrors(err error, fn func(err error, depth int)) {
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		if err == nil || depth > maxErrorDepth {
+			return
+		}
+
+		fn(err, depth)
+
+		for _, e := range unwrapErrors(err) {
+			walk(e, depth+1)
+		}
+	}
+
+	walk(err, 0)
+}
+
+// errorTree returns err's tree as an indented list with type and message of each error.
+func errorTree(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	var lines []string
+	walkErrors(err, func(e error, depth int) {
+		lines = append(lines, fmt.Sprintf("%s%T: %q", strings.Repeat("  ", depth), e, e.Error()))
+	})
+
+	return strings.Join(lines, "\n")
+}
+
+// isSameError returns true if actual is expected, or reports it is by Is(error) bool.
+// Unlike errors.Is, errors wrapped by actual are NOT checked.
+func isSameError(actual, expected error) bool {
+	if actual == nil || expected == nil {
+		return actual == expected
+	}
+
+	if reflect.TypeOf(expected).Comparable() && actual == expected {
+		return true
+	}
+
+	if x, ok := actual.(interface{ Is(error) bool }); ok {
+		return x.Is(expected)
+	}
+
+	return false
+}
+
+// quoteError returns the quoted message of err for failures, or <nil> for nil.
+func quoteError(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	return strconv.Quote(err.Error())
+}
+
+// isErrorTarget returns true if target is valid for errors.As, which panics otherwise.
+func isErrorTarget(target interface{}) bool {
+	if target == nil {
+		return false
+	}
+
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return false
+	}
+
+	elemType := value.Type().Elem()
+
+	return elemType.Kind() == reflect.Interface ||
+		elemType.Implements(reflect.TypeOf((*error)(nil)).Elem())
+}
+
 func toFloat(x interface{}) (float64, bool) {
 	var xf float64
 	xok := true
 
 	switch xn := x.(type) {
+	case uint:
+		xf = float64(xn)
+	case uintptr:
+		xf = float64(xn)
 	case uint8:
 		xf = float64(xn)
 	case uint16:
@@ -539,48 +958,61 @@ func toFloat(x interface{}) (float64, bool) {
 	return xf, xok
 }
 
-// diffValues returns a diff of both values as long as both are of the same type and
-// are a struct, map, slice or array. Otherwise, it returns an empty string.
-func diffValues(expected, actual interface{}) string {
+// diffValues returns a diff of both values painted with the colorPolicy as long as both are of
+// the same type and are a struct, map, slice or array. Otherwise, it returns an empty string.
+func diffValues(policy colorPolicy, expected, actual interface{}) string {
 	expectStr, actualStr := prettifyValues(expected, actual)
 
+	if diffs := diffTexts(policy, expectStr, actualStr); len(diffs) > 0 {
+		return diffs
+	}
+
+	diffs := pretty.Diff(expected, actual)
+	if len(diffs) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\n%v\n", diffs)
+}
+
+// diffTexts returns a unified diff of both texts line by line painted with the colorPolicy.
+// It returns an empty string if there is no difference.
+func diffTexts(policy colorPolicy, expected, actual string) string {
 	diffs, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
-		A:        difflib.SplitLines(expectStr),
-		B:        difflib.SplitLines(actualStr),
+		A:        difflib.SplitLines(expected),
+		B:        difflib.SplitLines(actual),
 		FromFile: "Expected",
 		FromDate: "",
 		ToFile:   "Actual",
 		ToDate:   "",
 		Context:  1,
 	})
-
 	if err != nil || len(diffs) == 0 {
-		diffs := pretty.Diff(expected, actual)
-		if len(diffs) == 0 {
-			return ""
-		}
-
-		return fmt.Sprintf("\n\n%v\n", diffs)
+		return ""
 	}
 
-	return fmt.Sprintf("\n\n%s\n", diffColorize(diffs))
+	return fmt.Sprintf("\n\n%s\n", diffColorize(policy, diffs))
 }
 
-func diffColorize(diffs string) string {
-	paint := colorize.New("yellow")
+// diffColorize paints lines of diffs with the palette of the colorPolicy, diffs are returned
+// as is if colors are disabled.
+func diffColorize(policy colorPolicy, diffs string) string {
+	if !policy.enabled {
+		return diffs
+	}
+
+	palette := policy.palette
 
 	lines := strings.Split(diffs, "\n")
 	for i, line := range lines {
 		switch {
 		case strings.HasPrefix(line, "+"):
-			paint.SetFgColor(colorize.ColorBlue)
+			lines[i] = paint(palette.Added, line)
 		case strings.HasPrefix(line, "-"):
-			paint.SetFgColor(colorize.ColorRed)
+			lines[i] = paint(palette.Removed, line)
 		default:
-			paint.SetFgColor(colorize.ColorGray)
+			lines[i] = paint(palette.Context, line)
 		}
-
-		lines[i] = paint.Paint(line)
 	}
 
 	return strings.Join(lines, "\n")
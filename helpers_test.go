@@ -1,7 +1,11 @@
 package assert
 
 import (
+	"fmt"
+	"math/big"
+	"net"
 	"testing"
+	"time"
 )
 
 func Test_AreEqualObjects(t *testing.T) {
@@ -61,6 +65,67 @@ func Test_AreEqualObjects(t *testing.T) {
 	}
 }
 
+type equalMethodID struct {
+	value string
+}
+
+func (id equalMethodID) Equal(other equalMethodID) bool {
+	return len(id.value) == len(other.value)
+}
+
+func Test_AreEqualObjectsWithEqualMethod(t *testing.T) {
+	now := time.Now()
+	type event struct {
+		At  time.Time
+		IPs []net.IP
+		N   *big.Int
+		IDs map[string]equalMethodID
+	}
+
+	// it should work
+	testCases := []struct {
+		expected interface{}
+		actual   interface{}
+	}{
+		{now, now.UTC()},
+		{now, now.Round(0)},
+		{net.ParseIP("127.0.0.1"), net.IPv4(127, 0, 0, 1).To4()},
+		{big.NewInt(1), new(big.Int).SetBytes([]byte{1})},
+		{equalMethodID{"foo"}, equalMethodID{"bar"}},
+		{
+			event{At: now, IPs: []net.IP{net.ParseIP("::1")}, N: big.NewInt(2), IDs: map[string]equalMethodID{"a": {"foo"}}},
+			event{At: now.UTC(), IPs: []net.IP{net.IPv6loopback}, N: big.NewInt(2), IDs: map[string]equalMethodID{"a": {"bar"}}},
+		},
+	}
+	for _, tc := range testCases {
+		if !AreEqualObjects(tc.expected, tc.actual) {
+			t.Errorf("Expect %#v is equal to %#v", tc.actual, tc.expected)
+		}
+	}
+
+	// it should not work
+	testCases = []struct {
+		expected interface{}
+		actual   interface{}
+	}{
+		{now, now.Add(time.Nanosecond)},
+		{big.NewInt(1), big.NewInt(2)},
+		{equalMethodID{"foo"}, equalMethodID{"foobar"}},
+		{event{N: big.NewInt(1)}, event{}},
+		{event{At: now}, event{At: now.Add(time.Second)}},
+	}
+	for _, tc := range testCases {
+		if AreEqualObjects(tc.expected, tc.actual) {
+			t.Errorf("Expect %#v is not equal to %#v", tc.actual, tc.expected)
+		}
+	}
+
+	// it should opt out
+	if areEqualWith(now, now.UTC(), IgnoreEqualMethods()) {
+		t.Errorf("Expect %#v is not equal to %#v with IgnoreEqualMethods", now.UTC(), now)
+	}
+}
+
 func Test_includeElement(t *testing.T) {
 
 	list1 := []string{"Foo", "Bar"}
@@ -168,3 +233,39 @@ func Test_panicRecovery(t *testing.T) {
 		t.Error("panicRecovery should return false for non paniced calling")
 	}
 }
+
+type mockTBTesting struct {
+	mockNamedTesting
+
+	helpers int
+	logs    []string
+	skipped bool
+}
+
+func (m *mockTBTesting) Helper() {
+	m.helpers++
+}
+
+func (m *mockTBTesting) Logf(format string, args ...interface{}) {
+	m.logs = append(m.logs, fmt.Sprintf(format, args...))
+}
+
+func (m *mockTBTesting) Skip(args ...interface{}) {
+	m.skipped = true
+}
+
+func Test_FailWithHelper(t *testing.T) {
+	mockT := &mockTBTesting{}
+
+	False(t, Equal(mockT, "want", "got", "hello, %v!", "world"))
+	True(t, mockT.helpers >= 2)
+
+	output := mockT.buf.String()
+	NotContains(t, output, "\r")
+	Contains(t, output, "\tError:   \tExpected values are NOT equal.")
+	Contains(t, output, "\tMessages:\thello, world!")
+
+	mockT = &mockTBTesting{}
+	False(t, New(mockT).Equal("want", "got"))
+	True(t, mockT.helpers >= 3)
+}
@@ -0,0 +1,352 @@
+// Package httpassert provides assertions for http.Handler built on top of net/http/httptest.
+// Failures are reported through assert.Fail with the full request and response dumped.
+//
+//	func TestHandler(t *testing.T) {
+//	  httpassert.HTTPStatus(t, handler, http.MethodGet, "/users/7", nil, http.StatusOK)
+//
+//	  httpassert.Request(t, handler, http.MethodGet, "/users/7", nil).
+//	    Expect().
+//	    Status(http.StatusOK).
+//	    JSON("data.id", 7)
+//	}
+package httpassert
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strings"
+
+	"github.com/golib/assert"
+)
+
+// exchange is a request served by the handler along with the recorded response.
+type exchange struct {
+	request     *http.Request
+	requestBody []byte
+	recorder    *httptest.ResponseRecorder
+}
+
+// newRequest creates a request for serving with httptest, the body is kept for dumping.
+func newRequest(method, url string, body io.Reader) (*http.Request, []byte) {
+	var data []byte
+	if body != nil {
+		data, _ = io.ReadAll(body)
+	}
+
+	return httptest.NewRequest(method, url, bytes.NewReader(data)), data
+}
+
+// serve serves the request with the handler and records the response.
+func serve(handler http.Handler, request *http.Request, requestBody []byte) *exchange {
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+
+	return &exchange{
+		request:     request,
+		requestBody: requestBody,
+		recorder:    recorder,
+	}
+}
+
+// dump returns the request and response in the wire format.
+func (e *exchange) dump() string {
+	request := e.request.Clone(e.request.Context())
+	request.Body = io.NopCloser(bytes.NewReader(e.requestBody))
+
+	requestDump, err := httputil.DumpRequest(request, true)
+	if err != nil {
+		requestDump = []byte(fmt.Sprintf("<failed to dump request: %v>", err))
+	}
+
+	responseDump, err := httputil.DumpResponse(e.recorder.Result(), true)
+	if err != nil {
+		responseDump = []byte(fmt.Sprintf("<failed to dump response: %v>", err))
+	}
+
+	return fmt.Sprintf("Request:\n%s\n\nResponse:\n%s",
+		strings.TrimSpace(string(requestDump)), strings.TrimSpace(string(responseDump)))
+}
+
+// messages returns formatAndArgs with the dump appended, which is reported as messages of the failure.
+// The dump is formatted lazily, i.e. only if the assertion fails.
+func (e *exchange) messages(formatAndArgs []any) []any {
+	return []any{"%s", exchangeMessages{exchange: e, formatAndArgs: formatAndArgs}}
+}
+
+// exchangeMessages is a fmt.Stringer of formatAndArgs with the dump of exchange appended.
+type exchangeMessages struct {
+	exchange      *exchange
+	formatAndArgs []any
+}
+
+func (m exchangeMessages) String() string {
+	messages := formatMessages(m.formatAndArgs...)
+	if messages != "" {
+		messages += "\n\n"
+	}
+
+	return messages + m.exchange.dump()
+}
+
+// formatMessages formats messages in the same way of assert.Fail.
+func formatMessages(formatAndArgs ...any) string {
+	switch len(formatAndArgs) {
+	case 0:
+		return ""
+
+	case 1:
+		return fmt.Sprintf("%v", formatAndArgs[0])
+	}
+
+	if format, ok := formatAndArgs[0].(string); ok {
+		return fmt.Sprintf(format, formatAndArgs[1:]...)
+	}
+
+	return fmt.Sprintf("%v", formatAndArgs)
+}
+
+func (e *exchange) status(t assert.Testing, code int, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	if e.recorder.Code != code {
+		return assert.Fail(t,
+			fmt.Sprintf("Expected HTTP status code %d, but got %d", code, e.recorder.Code),
+			e.messages(formatAndArgs)...)
+	}
+
+	return true
+}
+
+func (e *exchange) bodyContains(t assert.Testing, contains string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	if !strings.Contains(e.recorder.Body.String(), contains) {
+		return assert.Fail(t,
+			fmt.Sprintf("Expected HTTP body to contain %q", contains),
+			e.messages(formatAndArgs)...)
+	}
+
+	return true
+}
+
+func (e *exchange) header(t assert.Testing, key, value string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	values := e.recorder.Result().Header.Values(key)
+	if len(values) == 0 {
+		return assert.Fail(t,
+			fmt.Sprintf("Expected HTTP header %s of %q, but it is missing", key, value),
+			e.messages(formatAndArgs)...)
+	}
+
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return assert.Fail(t,
+		fmt.Sprintf("Expected HTTP header %s of %q, but got %q", key, value, values),
+		e.messages(formatAndArgs)...)
+}
+
+func (e *exchange) redirect(t assert.Testing, location string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	if e.recorder.Code < http.StatusMultipleChoices || e.recorder.Code >= http.StatusBadRequest {
+		return assert.Fail(t,
+			fmt.Sprintf("Expected HTTP redirect to %q, but got status code %d", location, e.recorder.Code),
+			e.messages(formatAndArgs)...)
+	}
+
+	if actual := e.recorder.Header().Get("Location"); location != "" && actual != location {
+		return assert.Fail(t,
+			fmt.Sprintf("Expected HTTP redirect to %q, but got %q", location, actual),
+			e.messages(formatAndArgs)...)
+	}
+
+	return true
+}
+
+func (e *exchange) json(t assert.Testing, key string, value any, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	return assert.ContainsJSON(t, e.recorder.Body.String(), key, value, e.messages(formatAndArgs)...)
+}
+
+func (e *exchange) cookie(t assert.Testing, name, value string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	for _, cookie := range e.recorder.Result().Cookies() {
+		if cookie.Name != name {
+			continue
+		}
+
+		if cookie.Value != value {
+			return assert.Fail(t,
+				fmt.Sprintf("Expected HTTP cookie %s of %q, but got %q", name, value, cookie.Value),
+				e.messages(formatAndArgs)...)
+		}
+
+		return true
+	}
+
+	return assert.Fail(t,
+		fmt.Sprintf("Expected HTTP cookie %s of %q, but it is missing", name, value),
+		e.messages(formatAndArgs)...)
+}
+
+// contentType compares media types, and parameters only if the expected has them, i.e. charset=utf-8.
+func (e *exchange) contentType(t assert.Testing, contentType string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	actual := e.recorder.Header().Get("Content-Type")
+
+	expectedType, expectedParams, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return assert.Fail(t,
+			fmt.Sprintf("Expected HTTP content type %q is invalid: %v", contentType, err),
+			e.messages(formatAndArgs)...)
+	}
+
+	actualType, actualParams, err := mime.ParseMediaType(actual)
+	matched := err == nil && actualType == expectedType
+	for key, value := range expectedParams {
+		matched = matched && strings.EqualFold(actualParams[key], value)
+	}
+
+	if !matched {
+		return assert.Fail(t,
+			fmt.Sprintf("Expected HTTP content type %q, but got %q", contentType, actual),
+			e.messages(formatAndArgs)...)
+	}
+
+	return true
+}
+
+// HTTPStatus asserts that the handler responds the request with the status code.
+//
+//	httpassert.HTTPStatus(t, handler, http.MethodGet, "/users/7", nil, http.StatusOK)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPStatus(t assert.Testing, handler http.Handler, method, url string, body io.Reader, code int, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	request, requestBody := newRequest(method, url, body)
+
+	return serve(handler, request, requestBody).status(t, code, formatAndArgs...)
+}
+
+// HTTPBodyContains asserts that body of the response contains the string.
+//
+//	httpassert.HTTPBodyContains(t, handler, http.MethodGet, "/hello", nil, "Hello, World")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPBodyContains(t assert.Testing, handler http.Handler, method, url string, body io.Reader, contains string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	request, requestBody := newRequest(method, url, body)
+
+	return serve(handler, request, requestBody).bodyContains(t, contains, formatAndArgs...)
+}
+
+// HTTPHeader asserts that the response has the header of the value.
+//
+//	httpassert.HTTPHeader(t, handler, http.MethodGet, "/hello", nil, "X-Cache", "HIT")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPHeader(t assert.Testing, handler http.Handler, method, url string, body io.Reader, key, value string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	request, requestBody := newRequest(method, url, body)
+
+	return serve(handler, request, requestBody).header(t, key, value, formatAndArgs...)
+}
+
+// HTTPRedirect asserts that the handler redirects the request with 3xx status code to the location,
+// empty location matches any.
+//
+//	httpassert.HTTPRedirect(t, handler, http.MethodGet, "/old", nil, "/new")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPRedirect(t assert.Testing, handler http.Handler, method, url string, body io.Reader, location string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	request, requestBody := newRequest(method, url, body)
+
+	return serve(handler, request, requestBody).redirect(t, location, formatAndArgs...)
+}
+
+// HTTPJSON asserts that JSON body of the response contains value of the key, see assert.ContainsJSON.
+//
+//	httpassert.HTTPJSON(t, handler, http.MethodGet, "/users/7", nil, "data.id", 7)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPJSON(t assert.Testing, handler http.Handler, method, url string, body io.Reader, key string, value any, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	request, requestBody := newRequest(method, url, body)
+
+	return serve(handler, request, requestBody).json(t, key, value, formatAndArgs...)
+}
+
+// HTTPCookie asserts that the response sets the cookie of the value.
+//
+//	httpassert.HTTPCookie(t, handler, http.MethodPost, "/login", body, "session", "s3cr3t")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPCookie(t assert.Testing, handler http.Handler, method, url string, body io.Reader, name, value string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	request, requestBody := newRequest(method, url, body)
+
+	return serve(handler, request, requestBody).cookie(t, name, value, formatAndArgs...)
+}
+
+// HTTPContentType asserts that the response is of the content type. Parameters of the content type,
+// i.e. charset, are compared only if they are given.
+//
+//	httpassert.HTTPContentType(t, handler, http.MethodGet, "/users/7", nil, "application/json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HTTPContentType(t assert.Testing, handler http.Handler, method, url string, body io.Reader, contentType string, formatAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	request, requestBody := newRequest(method, url, body)
+
+	return serve(handler, request, requestBody).contentType(t, contentType, formatAndArgs...)
+}
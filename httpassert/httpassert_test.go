@@ -0,0 +1,148 @@
+package httpassert
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golib/assert"
+	"github.com/golib/assert/asserttest"
+)
+
+func newTestHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-Cache", "HIT")
+
+		fmt.Fprintf(w, `{"data": {"id": %s, "name": "golib"}}`, r.PathValue("id"))
+	})
+
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s3cr3t"})
+		fmt.Fprintf(w, "Hello, %s", body)
+	})
+
+	mux.HandleFunc("GET /old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+	})
+
+	return mux
+}
+
+func TestHTTPAssertions(t *testing.T) {
+	handler := newTestHandler()
+
+	assert.True(t, HTTPStatus(t, handler, http.MethodGet, "/users/7", nil, http.StatusOK))
+	assert.True(t, HTTPStatus(t, handler, http.MethodGet, "/missing", nil, http.StatusNotFound))
+	assert.True(t, HTTPBodyContains(t, handler, http.MethodPost, "/login", strings.NewReader("golib"), "Hello, golib"))
+	assert.True(t, HTTPHeader(t, handler, http.MethodGet, "/users/7", nil, "X-Cache", "HIT"))
+	assert.True(t, HTTPRedirect(t, handler, http.MethodGet, "/old", nil, "/new"))
+	assert.True(t, HTTPRedirect(t, handler, http.MethodGet, "/old", nil, ""))
+	assert.True(t, HTTPJSON(t, handler, http.MethodGet, "/users/7", nil, "data.id", 7))
+	assert.True(t, HTTPCookie(t, handler, http.MethodPost, "/login", nil, "session", "s3cr3t"))
+	assert.True(t, HTTPContentType(t, handler, http.MethodGet, "/users/7", nil, "application/json"))
+	assert.True(t, HTTPContentType(t, handler, http.MethodGet, "/users/7", nil, "application/json; charset=UTF-8"))
+
+	// the request and response are dumped only if the assertion fails
+	recorder := httptest.NewRecorder()
+	recorder.WriteString(`{"data": {"id": 7}}`)
+
+	e := &exchange{recorder: recorder}
+	assert.NotPanics(t, func() {
+		assert.True(t, e.json(t, "data.id", 7))
+	})
+}
+
+func TestHTTPAssertionsFailure(t *testing.T) {
+	handler := newTestHandler()
+
+	for _, tc := range []struct {
+		name   string
+		assert func(rec *asserttest.Recorder) bool
+		error  string
+	}{
+		{"status", func(rec *asserttest.Recorder) bool {
+			return HTTPStatus(rec, handler, http.MethodGet, "/users/7", nil, http.StatusCreated)
+		}, "Expected HTTP status code 201, but got 200"},
+		{"body", func(rec *asserttest.Recorder) bool {
+			return HTTPBodyContains(rec, handler, http.MethodPost, "/login", strings.NewReader("golib"), "Bye")
+		}, `Expected HTTP body to contain "Bye"`},
+		{"header", func(rec *asserttest.Recorder) bool {
+			return HTTPHeader(rec, handler, http.MethodGet, "/users/7", nil, "X-Cache", "MISS")
+		}, `Expected HTTP header X-Cache of "MISS", but got ["HIT"]`},
+		{"missing header", func(rec *asserttest.Recorder) bool {
+			return HTTPHeader(rec, handler, http.MethodGet, "/users/7", nil, "X-Trace", "1")
+		}, `Expected HTTP header X-Trace of "1", but it is missing`},
+		{"redirect", func(rec *asserttest.Recorder) bool {
+			return HTTPRedirect(rec, handler, http.MethodGet, "/users/7", nil, "/new")
+		}, `Expected HTTP redirect to "/new", but got status code 200`},
+		{"redirect location", func(rec *asserttest.Recorder) bool {
+			return HTTPRedirect(rec, handler, http.MethodGet, "/old", nil, "/newer")
+		}, `Expected HTTP redirect to "/newer", but got "/new"`},
+		{"json", func(rec *asserttest.Recorder) bool {
+			return HTTPJSON(rec, handler, http.MethodGet, "/users/7", nil, "data.name", 7)
+		}, "key data.name is a string, expected number"},
+		{"cookie", func(rec *asserttest.Recorder) bool {
+			return HTTPCookie(rec, handler, http.MethodPost, "/login", nil, "session", "guess")
+		}, `Expected HTTP cookie session of "guess", but got "s3cr3t"`},
+		{"content type", func(rec *asserttest.Recorder) bool {
+			return HTTPContentType(rec, handler, http.MethodGet, "/users/7", nil, "text/plain")
+		}, `Expected HTTP content type "text/plain", but got "application/json; charset=utf-8"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := asserttest.NewRecorder(t)
+
+			assert.False(t, tc.assert(rec))
+			rec.AssertFailedWith("Error", tc.error)
+			rec.AssertFailedWith("Messages", "Request:\n")
+			rec.AssertFailedWith("Messages", "Response:\nHTTP/1.1 ")
+		})
+	}
+}
+
+func TestRequest(t *testing.T) {
+	handler := newTestHandler()
+
+	resp := Request(t, handler, http.MethodGet, "/users/7", nil).
+		Header("Accept", "application/json").
+		Cookie(&http.Cookie{Name: "session", Value: "s3cr3t"}).
+		Expect().
+		Status(http.StatusOK).
+		Header("X-Cache", "HIT").
+		ContentType("application/json").
+		BodyContains(`"golib"`).
+		JSON("data.id", 7).
+		JSON("/data/name", "golib")
+	assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+	assert.Contains(t, resp.Body(), `"id": 7`)
+
+	Request(t, handler, http.MethodPost, "/login", strings.NewReader("golib")).
+		Expect().
+		Cookie("session", "s3cr3t").
+		BodyContains("Hello, golib")
+
+	Request(t, handler, http.MethodGet, "/old", nil).
+		Expect().
+		Redirect("/new")
+
+	rec := asserttest.NewRecorder(t)
+	Request(rec, handler, http.MethodPost, "/login", strings.NewReader(`{"name": "golib"}`)).
+		Header("X-Request-Id", "42").
+		Expect().
+		Status(http.StatusOK).
+		JSON("data.id", 7, "user %s", "golib")
+
+	failures := rec.Failures()
+	assert.Len(t, failures, 1)
+	rec.AssertFailedWith("Messages", "user golib\n\nRequest:\nPOST /login HTTP/1.1")
+	rec.AssertFailedWith("Messages", "X-Request-Id: 42")
+	rec.AssertFailedWith("Messages", `{"name": "golib"}`)
+	rec.AssertFailedWith("Messages", "Set-Cookie: session=s3cr3t")
+}
@@ -0,0 +1,150 @@
+package httpassert
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/golib/assert"
+)
+
+// RequestBuilder builds a request served by the handler for fluent assertions.
+type RequestBuilder struct {
+	t           assert.Testing
+	handler     http.Handler
+	request     *http.Request
+	requestBody []byte
+}
+
+// Request creates a *RequestBuilder of the request served by the handler.
+//
+//	httpassert.Request(t, handler, http.MethodPost, "/users", strings.NewReader(`{"name": "golib"}`)).
+//	  Header("Content-Type", "application/json").
+//	  Expect().
+//	  Status(http.StatusCreated).
+//	  JSON("data.name", "golib")
+func Request(t assert.Testing, handler http.Handler, method, url string, body io.Reader) *RequestBuilder {
+	request, requestBody := newRequest(method, url, body)
+
+	return &RequestBuilder{
+		t:           t,
+		handler:     handler,
+		request:     request,
+		requestBody: requestBody,
+	}
+}
+
+// Header sets the header of the request.
+func (b *RequestBuilder) Header(key, value string) *RequestBuilder {
+	b.request.Header.Set(key, value)
+
+	return b
+}
+
+// Cookie adds the cookie to the request.
+func (b *RequestBuilder) Cookie(cookie *http.Cookie) *RequestBuilder {
+	b.request.AddCookie(cookie)
+
+	return b
+}
+
+// Expect serves the request with the handler, and returns a *Response for assertions.
+func (b *RequestBuilder) Expect() *Response {
+	return &Response{
+		t:        b.t,
+		exchange: serve(b.handler, b.request, b.requestBody),
+	}
+}
+
+// Response holds the recorded response for fluent assertions. Every assertion reports failure
+// through assert.Fail, and returns the *Response for chaining.
+type Response struct {
+	t        assert.Testing
+	exchange *exchange
+}
+
+// Result returns the recorded response.
+func (r *Response) Result() *http.Response {
+	return r.exchange.recorder.Result()
+}
+
+// Body returns body of the recorded response.
+func (r *Response) Body() string {
+	return r.exchange.recorder.Body.String()
+}
+
+// Status asserts that the response is of the status code.
+func (r *Response) Status(code int, formatAndArgs ...any) *Response {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	r.exchange.status(r.t, code, formatAndArgs...)
+
+	return r
+}
+
+// BodyContains asserts that body of the response contains the string.
+func (r *Response) BodyContains(contains string, formatAndArgs ...any) *Response {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	r.exchange.bodyContains(r.t, contains, formatAndArgs...)
+
+	return r
+}
+
+// Header asserts that the response has the header of the value.
+func (r *Response) Header(key, value string, formatAndArgs ...any) *Response {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	r.exchange.header(r.t, key, value, formatAndArgs...)
+
+	return r
+}
+
+// Redirect asserts that the response redirects to the location, empty location matches any.
+func (r *Response) Redirect(location string, formatAndArgs ...any) *Response {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	r.exchange.redirect(r.t, location, formatAndArgs...)
+
+	return r
+}
+
+// JSON asserts that JSON body of the response contains value of the key, see assert.ContainsJSON.
+func (r *Response) JSON(key string, value any, formatAndArgs ...any) *Response {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	r.exchange.json(r.t, key, value, formatAndArgs...)
+
+	return r
+}
+
+// Cookie asserts that the response sets the cookie of the value.
+func (r *Response) Cookie(name, value string, formatAndArgs ...any) *Response {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	r.exchange.cookie(r.t, name, value, formatAndArgs...)
+
+	return r
+}
+
+// ContentType asserts that the response is of the content type.
+func (r *Response) ContentType(contentType string, formatAndArgs ...any) *Response {
+	if h, ok := r.t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	r.exchange.contentType(r.t, contentType, formatAndArgs...)
+
+	return r
+}
@@ -0,0 +1,33 @@
+package httpassert_test
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/golib/assert"
+	"github.com/golib/assert/asserttest"
+	"github.com/golib/assert/httpassert"
+)
+
+// NOTE: callers of package httpassert are skipped in traces, tests of traces are in package httpassert_test.
+
+func lineOf(...any) string {
+	_, file, line, _ := runtime.Caller(1)
+
+	return fmt.Sprintf("httpassert/%s:%d", filepath.Base(file), line)
+}
+
+func Test_StackTraces(t *testing.T) {
+	rec := asserttest.NewRecorder(t)
+
+	line := lineOf(httpassert.HTTPStatus(rec, http.NotFoundHandler(), http.MethodGet, "/", nil, http.StatusOK))
+	assert.Equal(t, []string{line}, rec.Failures()[0].Trace)
+
+	rec = asserttest.NewRecorder(t)
+
+	line = lineOf(httpassert.Request(rec, http.NotFoundHandler(), http.MethodGet, "/", nil).Expect().Status(http.StatusOK))
+	assert.Equal(t, []string{line}, rec.Failures()[0].Trace)
+}
@@ -0,0 +1,97 @@
+// Package ordered compares values of ordered types for assertions of github.com/golib/assert
+// and matchers of github.com/golib/assert/match.
+package ordered
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Compare returns -1, 0 or +1 by comparing a with b. Numbers of any types, including
+// time.Duration, *big.Int and *big.Float, are compared by value, strings of any named
+// types are compared lexically and time.Time or *time.Time are compared by instant.
+func Compare(a, b any) (int, error) {
+	if at, ok := timeOf(a); ok {
+		bt, ok := timeOf(b)
+		if !ok {
+			return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+		}
+
+		return at.Compare(bt), nil
+	}
+
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if av.Kind() == reflect.String || bv.Kind() == reflect.String {
+		if av.Kind() != bv.Kind() {
+			return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+		}
+
+		return strings.Compare(av.String(), bv.String()), nil
+	}
+
+	if isNaN(a) || isNaN(b) {
+		return 0, errors.New("cannot compare NaN")
+	}
+
+	an, aok := Number(a)
+	bn, bok := Number(b)
+	if !aok || !bok {
+		return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+	}
+
+	return an.Cmp(bn), nil
+}
+
+// Number returns the number as *big.Float, which holds numbers of any types exactly, except NaN.
+func Number(v any) (*big.Float, bool) {
+	switch n := v.(type) {
+	case *big.Int:
+		if n != nil {
+			return new(big.Float).SetInt(n), true
+		}
+	case *big.Float:
+		if n != nil {
+			return n, true
+		}
+	}
+
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(value.Int()), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(value.Uint()), true
+
+	case reflect.Float32, reflect.Float64:
+		if f := value.Float(); !math.IsNaN(f) {
+			return new(big.Float).SetFloat64(f), true
+		}
+	}
+
+	return nil, false
+}
+
+func timeOf(v any) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t != nil {
+			return *t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func isNaN(v any) bool {
+	value := reflect.ValueOf(v)
+
+	return (value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64) && math.IsNaN(value.Float())
+}
@@ -0,0 +1,249 @@
+package assert
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kr/pretty"
+)
+
+// jsonLine is a record of JSON Lines with its line number starting from 1.
+type jsonLine struct {
+	line int
+	text string
+}
+
+// JSONLinesSubject holds records of newline-delimited JSON (JSON Lines, NDJSON) for assertions.
+// Values of records are located with the key syntax of ContainsJSON, and compared with EqualJSON semantics.
+// Empty key stands for the whole record.
+type JSONLinesSubject struct {
+	t       Testing
+	records []jsonLine
+}
+
+// JSONLines reads all records from the reader, blank lines are skipped. It reports invalid records
+// and read error immediately, and the reader is consumed without rewinding.
+//
+//	lines := assert.JSONLines(t, resp.Body)
+//	lines.Len(3)
+//	lines.All("level", `"info"`)
+//	lines.Any("/msg", `"started"`)
+//	lines.InOrder("msg", `"started"`, `"stopped"`)
+func JSONLines(t Testing, reader io.Reader) *JSONLinesSubject {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	s := &JSONLinesSubject{
+		t: t,
+	}
+
+	var (
+		buf     = bufio.NewReader(reader)
+		invalid []string
+		line    int
+	)
+	for {
+		text, err := buf.ReadString('\n')
+		if len(text) > 0 {
+			line++
+
+			text = strings.TrimRight(text, "\r\n")
+			if strings.TrimSpace(text) != "" {
+				if _, decodeErr := decodeJSON(text); decodeErr != nil {
+					invalid = append(invalid, fmt.Sprintf("line %d: %v", line, decodeErr))
+				} else {
+					s.records = append(s.records, jsonLine{line: line, text: text})
+				}
+			}
+		}
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				Fail(t, pretty.Sprintf("Failed to read JSON Lines from \"%T\" after line %d: %v", reader, line, err))
+			}
+
+			break
+		}
+	}
+
+	if len(invalid) > 0 {
+		Fail(t, fmt.Sprintf("Expected valid JSON Lines, but got %d invalid record(s):\n%s", len(invalid), strings.Join(invalid, "\n")))
+	}
+
+	return s
+}
+
+// JSONLines reads all records of newline-delimited JSON from the reader for assertions.
+//
+//	it.JSONLines(resp.Body).All("level", `"info"`)
+func (it *Assertions) JSONLines(reader io.Reader) *JSONLinesSubject {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return JSONLines(it.t, reader)
+}
+
+// Len asserts that there are count valid records.
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *JSONLinesSubject) Len(count int, formatAndArgs ...any) bool {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(s.records) != count {
+		return Fail(s.t,
+			fmt.Sprintf("Expected %d JSON record(s), but got %d", count, len(s.records)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// All asserts that value of the key is equal to the expected JSON for every record.
+//
+//	lines.All("level", `"info"`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *JSONLinesSubject) All(key, expected string, formatAndArgs ...any) bool {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	expectedValue, err := decodeJSON(expected)
+	if err != nil {
+		return Fail(s.t,
+			pretty.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()),
+			formatAndArgs...)
+	}
+
+	var mismatches []string
+	for _, record := range s.records {
+		if diffs := record.match(key, expectedValue); len(diffs) > 0 {
+			mismatches = append(mismatches, fmt.Sprintf("line %d: %s", record.line, strings.Join(diffs, "; ")))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return Fail(s.t,
+			fmt.Sprintf("Expected every JSON record with key %q of %s, but %d of %d record(s) mismatched:\n%s",
+				key, expected, len(mismatches), len(s.records), strings.Join(mismatches, "\n")),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// Any asserts that value of the key is equal to the expected JSON for at least one record.
+//
+//	lines.Any("/msg", `"started"`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *JSONLinesSubject) Any(key, expected string, formatAndArgs ...any) bool {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	expectedValue, err := decodeJSON(expected)
+	if err != nil {
+		return Fail(s.t,
+			pretty.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()),
+			formatAndArgs...)
+	}
+
+	mismatches := make([]string, 0, len(s.records))
+	for _, record := range s.records {
+		diffs := record.match(key, expectedValue)
+		if len(diffs) == 0 {
+			return true
+		}
+
+		mismatches = append(mismatches, fmt.Sprintf("line %d: %s", record.line, strings.Join(diffs, "; ")))
+	}
+
+	return Fail(s.t,
+		fmt.Sprintf("Expected a JSON record with key %q of %s, but none of %d record(s) matched:\n%s",
+			key, expected, len(s.records), strings.Join(mismatches, "\n")),
+		formatAndArgs...)
+}
+
+// InOrder asserts that records with value of the key equal to each expected JSON appear in the order,
+// records between them are ignored.
+//
+//	lines.InOrder("msg", `"started"`, `"stopped"`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (s *JSONLinesSubject) InOrder(key string, expected ...string) bool {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	next, previous := 0, 0
+	for i, value := range expected {
+		expectedValue, err := decodeJSON(value)
+		if err != nil {
+			return Fail(s.t,
+				pretty.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", value, err.Error()))
+		}
+
+		found := false
+		for ; next < len(s.records); next++ {
+			if len(s.records[next].match(key, expectedValue)) == 0 {
+				found = true
+				previous = s.records[next].line
+				next++
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		// NOTE: report where the record appears if it is out of order
+		var lines []string
+		for _, record := range s.records {
+			if len(record.match(key, expectedValue)) == 0 {
+				lines = append(lines, fmt.Sprint(record.line))
+			}
+		}
+
+		message := fmt.Sprintf("Expected JSON record #%d with key %q of %s after line %d, but got none", i+1, key, value, previous)
+		if len(lines) > 0 {
+			message += fmt.Sprintf(", it appears at line(s) %s", strings.Join(lines, ", "))
+		}
+
+		return Fail(s.t, message)
+	}
+
+	return true
+}
+
+// match returns mismatches of value of the key with EqualJSON semantics, the whole record is used for empty key.
+func (record jsonLine) match(key string, expected any) []string {
+	data := []byte(record.text)
+	if key != "" {
+		raw, err := getJsonRawValue(record.text, key)
+		if err != nil {
+			return []string{fmt.Sprintf("key %q: %v", key, err)}
+		}
+
+		data = raw
+	}
+
+	actual, err := decodeJSON(string(data))
+	if err != nil {
+		return []string{fmt.Sprintf("key %q: %v", key, err)}
+	}
+
+	m := &jsonMatcher{
+		opts: jsonOptions{strict: true, numbersByValue: true},
+	}
+	m.match("", expected, actual)
+
+	return m.diffs
+}
@@ -0,0 +1,72 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+const jsonLinesStream = `{"level": "info", "msg": "started", "seq": 1}
+{"level": "warn", "msg": "slow", "seq": 2.0}
+
+{"level": "info", "msg": "stopped", "seq": 3, "meta": {"code": 0}}
+`
+
+func Test_JSONLines(t *testing.T) {
+	lines := JSONLines(t, strings.NewReader(jsonLinesStream))
+
+	True(t, lines.Len(3))
+	True(t, lines.Any("msg", `"slow"`))
+	True(t, lines.Any("/meta", `{"code": 0}`))
+	True(t, lines.Any("$.seq", `2`))
+	True(t, lines.InOrder("msg", `"started"`, `"stopped"`))
+	True(t, lines.InOrder("seq", `1`, `3`))
+
+	bufT := &bufferT{}
+	lines = JSONLines(bufT, strings.NewReader(jsonLinesStream))
+	False(t, lines.Len(2))
+	Contains(t, bufT.buf.String(), "Expected 2 JSON record(s), but got 3")
+
+	// NOTE: placeholders are matched literally with EqualJSON semantics
+	bufT = &bufferT{}
+	lines = JSONLines(bufT, strings.NewReader(jsonLinesStream))
+	False(t, lines.All("seq", `"<<ANY_NUMBER>>"`))
+	Contains(t, strings.ReplaceAll(bufT.buf.String(), `\"`, `"`), `line 1: /: expected "<<ANY_NUMBER>>", but got 1`)
+
+	bufT = &bufferT{}
+	lines = JSONLines(bufT, strings.NewReader(jsonLinesStream))
+	False(t, lines.All("level", `"info"`))
+	output := strings.ReplaceAll(bufT.buf.String(), `\"`, `"`)
+	Contains(t, output, "1 of 3 record(s) mismatched")
+	Contains(t, output, `line 2: /: expected "info", but got "warn"`)
+
+	bufT = &bufferT{}
+	lines = JSONLines(bufT, strings.NewReader(jsonLinesStream))
+	False(t, lines.Any("meta.code", `1`))
+	output = bufT.buf.String()
+	Contains(t, output, "none of 3 record(s) matched")
+	Contains(t, output, "line 4: /: expected 1, but got 0")
+
+	bufT = &bufferT{}
+	lines = JSONLines(bufT, strings.NewReader(jsonLinesStream))
+	False(t, lines.InOrder("msg", `"stopped"`, `"started"`))
+	Contains(t, bufT.buf.String(), "record #2 with key")
+	Contains(t, bufT.buf.String(), "after line 4, but got none, it appears at line(s) 1")
+}
+
+func Test_JSONLinesWithInvalidRecord(t *testing.T) {
+	bufT := &bufferT{}
+	lines := JSONLines(bufT, strings.NewReader("{\"a\": 1}\r\nnot json\n{\"a\": 2}"))
+	Contains(t, bufT.buf.String(), "Expected valid JSON Lines, but got 1 invalid record(s):")
+	Contains(t, bufT.buf.String(), "line 2: invalid character")
+	True(t, lines.Len(2))
+	True(t, lines.InOrder("a", `1`, `2`))
+
+	bufT = &bufferT{}
+	lines = JSONLines(bufT, iotest.TimeoutReader(strings.NewReader("{\"a\": 1}\n")))
+	Contains(t, bufT.buf.String(), iotest.ErrTimeout.Error())
+	True(t, lines.Len(1))
+
+	it := New(t)
+	it.JSONLines(strings.NewReader("{\"a\": [1, 2]}\n{\"a\": [1, 2.0]}")).All("a", `[1, 2]`)
+}
@@ -0,0 +1,571 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kr/pretty"
+)
+
+// JSONArrayMode defines how arrays of JSON are matched.
+type JSONArrayMode int
+
+const (
+	// JSONArrayExact matches arrays of the same length element by element in order.
+	JSONArrayExact JSONArrayMode = iota
+
+	// JSONArrayAnyOrder matches arrays of the same length, elements are matched in any order.
+	JSONArrayAnyOrder
+
+	// JSONArrayPrefix matches arrays which start with the expected elements in order.
+	JSONArrayPrefix
+)
+
+// Placeholders of expected JSON, which match volatile values of actual JSON.
+const (
+	// JSONPresence matches any value, including null.
+	JSONPresence = "<<PRESENCE>>"
+
+	// JSONAnyNumber matches any number.
+	JSONAnyNumber = "<<ANY_NUMBER>>"
+
+	// JSONAnyString matches any string.
+	JSONAnyString = "<<ANY_STRING>>"
+
+	// JSONAnyBool matches true and false.
+	JSONAnyBool = "<<ANY_BOOL>>"
+
+	// jsonRegexPrefix is prefix of placeholder which matches strings with the regexp, i.e. <<REGEX:^usr_>>.
+	jsonRegexPrefix = "<<REGEX:"
+)
+
+// JSONOption config matching of JSON documents.
+type JSONOption func(opts *jsonOptions)
+
+type jsonOptions struct {
+	arrayMode      JSONArrayMode
+	numbersByValue bool
+	ignorePaths    [][]string
+
+	// strict reports keys of actual object missing from expected
+	strict bool
+
+	// placeholders matches strings of expected, i.e. JSONPresence, as placeholders rather than literals
+	placeholders bool
+}
+
+func newJSONOptions(defaults jsonOptions, options ...JSONOption) jsonOptions {
+	opts := defaults
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	return opts
+}
+
+// WithJSONArrayMode sets how arrays are matched, it is JSONArrayExact by default.
+//
+//	assert.JSONSubset(t, `{"tags": ["b", "a"]}`, actual, assert.WithJSONArrayMode(assert.JSONArrayAnyOrder))
+func WithJSONArrayMode(mode JSONArrayMode) JSONOption {
+	return func(opts *jsonOptions) {
+		opts.arrayMode = mode
+	}
+}
+
+// IgnoreJSONArrayOrder matches arrays in any order, which is the same as WithJSONArrayMode(JSONArrayAnyOrder).
+func IgnoreJSONArrayOrder() JSONOption {
+	return WithJSONArrayMode(JSONArrayAnyOrder)
+}
+
+// IgnoreJSONPaths ignores values with the JSON Pointers and everything under them.
+// A segment of "*" matches any key or index.
+//
+//	assert.EqualJSONWith(t, expected, actual, assert.IgnoreJSONPaths("/id", "/items/*/created_at"))
+func IgnoreJSONPaths(pointers ...string) JSONOption {
+	return func(opts *jsonOptions) {
+		for _, pointer := range pointers {
+			opts.ignorePaths = append(opts.ignorePaths, splitJSONPointer(pointer))
+		}
+	}
+}
+
+// JSONNumbersByLiteral compares numbers by literal rather than value, i.e. 1 != 1.0 != 1e0.
+// Numbers are compared by value by default.
+func JSONNumbersByLiteral() JSONOption {
+	return func(opts *jsonOptions) {
+		opts.numbersByValue = false
+	}
+}
+
+// isIgnoredPath returns true if the JSON Pointer is under any path of IgnoreJSONPaths.
+func (opts *jsonOptions) isIgnoredPath(path string) bool {
+	if len(opts.ignorePaths) == 0 {
+		return false
+	}
+
+	segments := splitJSONPointer(path)
+	for _, ignored := range opts.ignorePaths {
+		if len(ignored) > len(segments) {
+			continue
+		}
+
+		matched := true
+		for i, segment := range ignored {
+			if segment != "*" && segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withoutIgnoredPaths returns a copy of the value at the path without values under IgnoreJSONPaths,
+// which keeps them out of diffs of both JSON.
+func (opts *jsonOptions) withoutIgnoredPaths(path string, value any) any {
+	if len(opts.ignorePaths) == 0 {
+		return value
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		object := make(map[string]any, len(v))
+		for key, item := range v {
+			keyPath := path + "/" + escapeJSONPointer(key)
+			if !opts.isIgnoredPath(keyPath) {
+				object[key] = opts.withoutIgnoredPaths(keyPath, item)
+			}
+		}
+
+		return object
+
+	case []any:
+		array := make([]any, 0, len(v))
+		for i, item := range v {
+			itemPath := path + "/" + strconv.Itoa(i)
+			if !opts.isIgnoredPath(itemPath) {
+				array = append(array, opts.withoutIgnoredPaths(itemPath, item))
+			}
+		}
+
+		return array
+	}
+
+	return value
+}
+
+// splitJSONPointer returns unescaped segments of the JSON Pointer, the root is of no segment.
+func splitJSONPointer(pointer string) []string {
+	if pointer == "" || pointer == "/" {
+		return nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+	}
+
+	return segments
+}
+
+// jsonMatcher walks decoded expected and actual JSON, and records every mismatch with JSON Pointer of it.
+type jsonMatcher struct {
+	opts  jsonOptions
+	diffs []string
+}
+
+func (m *jsonMatcher) report(path, format string, args ...any) {
+	if path == "" {
+		path = "/"
+	}
+
+	m.diffs = append(m.diffs, path+": "+fmt.Sprintf(format, args...))
+}
+
+// isMatch returns true if actual matches expected at the path without recording mismatches.
+func (m *jsonMatcher) isMatch(path string, expected, actual any) bool {
+	sub := &jsonMatcher{opts: m.opts}
+	sub.match(path, expected, actual)
+
+	return len(sub.diffs) == 0
+}
+
+// match matches actual with expected, keys of actual object missing from expected are ignored unless strict.
+func (m *jsonMatcher) match(path string, expected, actual any) {
+	if m.opts.isIgnoredPath(path) {
+		return
+	}
+
+	if s, ok := expected.(string); ok && m.opts.placeholders && isJSONPlaceholder(s) {
+		if err := matchJSONPlaceholder(s, actual); err != nil {
+			m.report(path, "%v", err)
+		}
+
+		return
+	}
+
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			m.report(path, "expected object, but got %s", formatJSONValue(actual))
+			return
+		}
+
+		keys := make([]string, 0, len(e))
+		for key := range e {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			keyPath := path + "/" + escapeJSONPointer(key)
+
+			value, ok := a[key]
+			if !ok {
+				m.report(keyPath, "missing, expected %s", formatJSONValue(e[key]))
+				continue
+			}
+
+			m.match(keyPath, e[key], value)
+		}
+
+		if !m.opts.strict {
+			return
+		}
+
+		extras := make([]string, 0, len(a))
+		for key := range a {
+			if _, ok := e[key]; !ok {
+				extras = append(extras, key)
+			}
+		}
+		sort.Strings(extras)
+
+		for _, key := range extras {
+			keyPath := path + "/" + escapeJSONPointer(key)
+			if !m.opts.isIgnoredPath(keyPath) {
+				m.report(keyPath, "unexpected, got %s", formatJSONValue(a[key]))
+			}
+		}
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			m.report(path, "expected array, but got %s", formatJSONValue(actual))
+			return
+		}
+
+		m.matchArray(path, e, a)
+
+	default:
+		if !isJSONScalarEqual(expected, actual, m.opts.numbersByValue) {
+			m.report(path, "expected %s, but got %s", formatJSONValue(expected), formatJSONValue(actual))
+		}
+	}
+}
+
+func (m *jsonMatcher) matchArray(path string, expected, actual []any) {
+	switch m.opts.arrayMode {
+	case JSONArrayPrefix:
+		if len(actual) < len(expected) {
+			m.report(path, "expected at least %d item(s), but got %d", len(expected), len(actual))
+			return
+		}
+
+	default:
+		if len(actual) != len(expected) {
+			m.report(path, "expected %d item(s), but got %d", len(expected), len(actual))
+			return
+		}
+	}
+
+	if m.opts.arrayMode != JSONArrayAnyOrder {
+		for i := range expected {
+			m.match(path+"/"+strconv.Itoa(i), expected[i], actual[i])
+		}
+
+		return
+	}
+
+	// find a distinct actual item for each expected item with augmenting paths
+	matches := make([][]bool, len(expected))
+	for i := range expected {
+		matches[i] = make([]bool, len(actual))
+		for j := range actual {
+			matches[i][j] = m.isMatch(path+"/"+strconv.Itoa(i), expected[i], actual[j])
+		}
+	}
+
+	owners := make([]int, len(actual))
+	for j := range owners {
+		owners[j] = -1
+	}
+
+	var assign func(i int, seen []bool) bool
+	assign = func(i int, seen []bool) bool {
+		for j := range actual {
+			if !matches[i][j] || seen[j] {
+				continue
+			}
+
+			seen[j] = true
+			if owners[j] < 0 || assign(owners[j], seen) {
+				owners[j] = i
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i := range expected {
+		if !assign(i, make([]bool, len(actual))) {
+			m.report(path+"/"+strconv.Itoa(i), "no item matches %s", formatJSONValue(expected[i]))
+		}
+	}
+}
+
+func isJSONPlaceholder(s string) bool {
+	return strings.HasPrefix(s, "<<") && strings.HasSuffix(s, ">>")
+}
+
+// matchJSONPlaceholder returns error if actual does not match the placeholder.
+// Unknown placeholders are matched as plain strings.
+func matchJSONPlaceholder(placeholder string, actual any) error {
+	matched := false
+	switch placeholder {
+	case JSONPresence:
+		matched = true
+
+	case JSONAnyNumber:
+		_, matched = actual.(json.Number)
+
+	case JSONAnyString:
+		_, matched = actual.(string)
+
+	case JSONAnyBool:
+		_, matched = actual.(bool)
+
+	default:
+		if !strings.HasPrefix(placeholder, jsonRegexPrefix) {
+			matched = placeholder == actual
+			break
+		}
+
+		pattern := strings.TrimSuffix(strings.TrimPrefix(placeholder, jsonRegexPrefix), ">>")
+
+		reg, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid placeholder %s: %v", placeholder, err)
+		}
+
+		s, ok := actual.(string)
+		matched = ok && reg.MatchString(s)
+	}
+
+	if !matched {
+		return fmt.Errorf("expected %s, but got %s", placeholder, formatJSONValue(actual))
+	}
+
+	return nil
+}
+
+// isJSONScalarEqual compares scalars of JSON, numbers are compared by literal unless byValue, i.e. 1 == 1.0.
+func isJSONScalarEqual(expected, actual any, byValue bool) bool {
+	e, ok := expected.(json.Number)
+	if !ok {
+		return expected == actual
+	}
+
+	a, ok := actual.(json.Number)
+	if !ok {
+		return false
+	}
+
+	if !byValue {
+		return e == a
+	}
+
+	return isJSONNumberEqual(e, a)
+}
+
+// isJSONNumberEqual compares numbers by value with rational numbers, which is precise for large integers.
+func isJSONNumberEqual(expected, actual json.Number) bool {
+	if expected == actual {
+		return true
+	}
+
+	e, ok := new(big.Rat).SetString(expected.String())
+	if !ok {
+		return false
+	}
+
+	a, ok := new(big.Rat).SetString(actual.String())
+	if !ok {
+		return false
+	}
+
+	return e.Cmp(a) == 0
+}
+
+// formatJSONValue returns compact JSON of the value without escaping HTML characters.
+func formatJSONValue(value any) string {
+	buf := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// JSONSubset asserts that every key and value of the expected JSON is present in the actual JSON
+// recursively, extra keys of the actual are ignored. Arrays are matched with JSONArrayExact and
+// numbers are compared by value by default, and placeholders, i.e. JSONPresence, JSONAnyNumber and <<REGEX:^usr_>>, match volatile values.
+//
+//	assert.JSONSubset(t, `{"id": "<<REGEX:^usr_>>", "tags": ["admin"]}`, `{"id": "usr_1", "name": "golib", "tags": ["admin"]}`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func JSONSubset(t Testing, expected, actual string, options ...JSONOption) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	expectedValue, err := decodeJSON(expected)
+	if err != nil {
+		return Fail(t, pretty.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()))
+	}
+
+	actualValue, err := decodeJSON(actual)
+	if err != nil {
+		return Fail(t, pretty.Sprintf("Input ('%s') needs to be valid json.\nJSON parsing error: '%s'", actual, err.Error()))
+	}
+
+	m := &jsonMatcher{
+		opts: newJSONOptions(jsonOptions{numbersByValue: true, placeholders: true}, options...),
+	}
+	m.match("", expectedValue, actualValue)
+
+	if len(m.diffs) > 0 {
+		return Fail(t, fmt.Sprintf("Expected JSON is NOT a subset of actual, %d mismatch(es):\n%s", len(m.diffs), strings.Join(m.diffs, "\n")))
+	}
+
+	return true
+}
+
+// JSONSubset asserts that every key and value of the expected JSON is present in the actual JSON recursively.
+//
+//	it.JSONSubset(`{"id": "<<ANY_NUMBER>>"}`, `{"id": 1, "name": "golib"}`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) JSONSubset(expected, actual string, options ...JSONOption) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return JSONSubset(it.t, expected, actual, options...)
+}
+
+// EqualJSONWith asserts that two JSON strings are equivalent with the options, and reports
+// every mismatch with JSON Pointer of it along with a diff of both indented JSON.
+// Numbers are compared by value as EqualJSON unless JSONNumbersByLiteral.
+//
+//	assert.EqualJSONWith(t, expected, actual, assert.IgnoreJSONPaths("/id"), assert.IgnoreJSONArrayOrder())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EqualJSONWith(t Testing, expected, actual string, options ...JSONOption) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return equalJSON(t, expected, actual, newJSONOptions(jsonOptions{numbersByValue: true}, options...))
+}
+
+// EqualJSONWith asserts that two JSON strings are equivalent with the options.
+//
+//	it.EqualJSONWith(`{"id": 1, "n": 1.0}`, `{"id": 2, "n": 1}`, assert.IgnoreJSONPaths("/id"))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) EqualJSONWith(expected, actual string, options ...JSONOption) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return EqualJSONWith(it.t, expected, actual, options...)
+}
+
+func equalJSON(t Testing, expected, actual string, opts jsonOptions, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	expectedValue, err := decodeJSON(expected)
+	if err != nil {
+		return Fail(t,
+			pretty.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()),
+			formatAndArgs...)
+	}
+
+	actualValue, err := decodeJSON(actual)
+	if err != nil {
+		return Fail(t,
+			pretty.Sprintf("Input ('%s') needs to be valid json.\nJSON parsing error: '%s'", actual, err.Error()),
+			formatAndArgs...)
+	}
+
+	opts.strict = true
+
+	m := &jsonMatcher{
+		opts: opts,
+	}
+	m.match("", expectedValue, actualValue)
+
+	if len(m.diffs) > 0 {
+		values := failureValues{
+			expected: indentJSONValue(expectedValue),
+			actual:   indentJSONValue(actualValue),
+			diff:     strings.Join(m.diffs, "\n"),
+		}
+
+		diffs := diffTexts(
+			diffColorOf(t),
+			indentJSONValue(opts.withoutIgnoredPaths("", expectedValue)),
+			indentJSONValue(opts.withoutIgnoredPaths("", actualValue)),
+		)
+
+		return failWithValues(t,
+			fmt.Sprintf("Expected JSON are NOT equal, %d mismatch(es):\n%s%s",
+				len(m.diffs), values.diff, diffs),
+			values,
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// indentJSONValue returns indented JSON of the value with keys sorted, which makes diffs stable.
+func indentJSONValue(value any) string {
+	buf := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,152 @@
+package assert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const jsonSubsetActual = `{
+	"id": "usr_123",
+	"name": "golib",
+	"age": 18,
+	"score": 9.5,
+	"active": true,
+	"deleted_at": null,
+	"tags": ["admin", "dev", "ops"],
+	"address": {"city": "Shanghai", "zip": "200000"},
+	"orders": [{"id": 1, "total": 10}, {"id": 2, "total": 20}]
+}`
+
+func Test_JSONSubset(t *testing.T) {
+	True(t, JSONSubset(t, `{}`, jsonSubsetActual))
+	True(t, JSONSubset(t, `{"name": "golib", "address": {"city": "Shanghai"}}`, jsonSubsetActual))
+	True(t, JSONSubset(t, `{"age": 18.0, "score": 9.50}`, jsonSubsetActual))
+	True(t, JSONSubset(t, `{"orders": [{"id": 1}, {"total": 20}]}`, jsonSubsetActual))
+	True(t, JSONSubset(t, `{
+		"id": "<<REGEX:^usr_[0-9]+$>>",
+		"name": "<<ANY_STRING>>",
+		"age": "<<ANY_NUMBER>>",
+		"active": "<<ANY_BOOL>>",
+		"deleted_at": "<<PRESENCE>>"
+	}`, jsonSubsetActual))
+	True(t, JSONSubset(t, `[1, {"a": 1}]`, `[1, {"a": 1, "b": 2}]`))
+
+	bufT := &bufferT{}
+	False(t, JSONSubset(bufT, `{
+		"id": "<<REGEX:^grp_>>",
+		"name": "assert",
+		"email": "<<PRESENCE>>",
+		"address": {"zip": 200000},
+		"tags": ["admin"],
+		"orders": [{"id": 1}, {"id": 3}],
+		"score": "<<ANY_STRING>>"
+	}`, jsonSubsetActual))
+
+	output := bufT.buf.String()
+	Contains(t, output, "Expected JSON is NOT a subset of actual, 7 mismatch(es):")
+	for _, diff := range []string{
+		`/address/zip: expected 200000, but got "200000"`,
+		`/email: missing, expected "<<PRESENCE>>"`,
+		`/id: expected <<REGEX:^grp_>>, but got "usr_123"`,
+		`/name: expected "assert", but got "golib"`,
+		`/orders/1/id: expected 3, but got 2`,
+		`/score: expected <<ANY_STRING>>, but got 9.5`,
+		`/tags: expected 1 item(s), but got 3`,
+	} {
+		Contains(t, strings.ReplaceAll(output, `\"`, `"`), diff)
+	}
+
+	bufT = &bufferT{}
+	False(t, JSONSubset(bufT, `{"name": "golib"}`, `not json`))
+	Contains(t, bufT.buf.String(), "needs to be valid json")
+
+	bufT = &bufferT{}
+	False(t, JSONSubset(bufT, `[]`, `{}`))
+	Contains(t, bufT.buf.String(), "/: expected array, but got {}")
+}
+
+func Test_JSONSubsetWithArrayMode(t *testing.T) {
+	anyOrder := WithJSONArrayMode(JSONArrayAnyOrder)
+	prefix := WithJSONArrayMode(JSONArrayPrefix)
+
+	False(t, JSONSubset(&bufferT{}, `{"tags": ["ops", "admin", "dev"]}`, jsonSubsetActual))
+	True(t, JSONSubset(t, `{"tags": ["ops", "admin", "dev"]}`, jsonSubsetActual, anyOrder))
+	True(t, JSONSubset(t, `{"orders": [{"id": 2}, {"id": 1}]}`, jsonSubsetActual, anyOrder))
+	True(t, JSONSubset(t, `[{"a": 1}, {"a": 1, "b": 2}]`, `[{"a": 1, "b": 2}, {"a": 1}]`, anyOrder))
+	False(t, JSONSubset(&bufferT{}, `{"tags": ["ops", "admin"]}`, jsonSubsetActual, anyOrder))
+
+	bufT := &bufferT{}
+	False(t, JSONSubset(bufT, `{"tags": ["ops", "ops", "dev"]}`, jsonSubsetActual, anyOrder))
+	Contains(t, strings.ReplaceAll(bufT.buf.String(), `\"`, `"`), `/tags/1: no item matches "ops"`)
+
+	True(t, JSONSubset(t, `{"tags": ["admin", "dev"]}`, jsonSubsetActual, prefix))
+	True(t, JSONSubset(t, `{"tags": []}`, jsonSubsetActual, prefix))
+	False(t, JSONSubset(&bufferT{}, `{"tags": ["dev"]}`, jsonSubsetActual, prefix))
+	False(t, JSONSubset(&bufferT{}, `{"tags": ["admin", "dev", "ops", "qa"]}`, jsonSubsetActual, prefix))
+
+	it := New(t)
+	it.JSONSubset(`{"tags": ["<<ANY_STRING>>"]}`, jsonSubsetActual, prefix)
+}
+
+func Test_EqualJSONWith(t *testing.T) {
+	True(t, EqualJSONWith(t, `{"a": [1, 2], "b": {"c": "d"}}`, `{"b": {"c": "d"}, "a": [1, 2]}`))
+	True(t, EqualJSONWith(t, `{"n": 1}`, `{"n": 1.0}`))
+	True(t, EqualJSONWith(t, `{"id": 1, "n": 1}`, `{"id": 2, "n": 1.0}`, IgnoreJSONPaths("/id")))
+	False(t, EqualJSONWith(&bufferT{}, `{"n": 1}`, `{"n": 1.0}`, JSONNumbersByLiteral()))
+	True(t, EqualJSONWith(t, `{"n": 1.0}`, `{"n": 1.0}`, JSONNumbersByLiteral()))
+	False(t, JSONSubset(&bufferT{}, `{"n": 1}`, `{"n": 1.0}`, JSONNumbersByLiteral()))
+	True(t, EqualJSONWith(t, `[1, 2, 3]`, `[3, 1, 2]`, IgnoreJSONArrayOrder()))
+	True(t, EqualJSONWith(t,
+		`{"id": 1, "items": [{"id": 1, "name": "a"}]}`,
+		`{"id": 2, "items": [{"id": 3, "name": "a"}], "created_at": "now"}`,
+		IgnoreJSONPaths("/id", "/items/*/id", "/created_at")))
+
+	bufT := &bufferT{}
+	False(t, EqualJSONWith(bufT,
+		`{"name": "golib", "tags": ["a", "b"], "meta": {"n": 1}}`,
+		`{"name": "assert", "tags": ["a", "c"], "meta": {"n": 1, "x": true}}`))
+
+	output := strings.ReplaceAll(bufT.buf.String(), `\"`, `"`)
+	Contains(t, output, "Expected JSON are NOT equal, 3 mismatch(es):")
+	Contains(t, output, `/meta/x: unexpected, got true`)
+	Contains(t, output, `/name: expected "golib", but got "assert"`)
+	Contains(t, output, `/tags/1: expected "b", but got "c"`)
+	Contains(t, output, `--- Expected`)
+	Contains(t, output, `+  "name": "assert",`)
+
+	// NOTE: values of ignored paths are dropped from the diff
+	bufT = &bufferT{}
+	False(t, EqualJSONWith(bufT,
+		`{"id": 1, "name": "golib", "items": [{"id": 1}]}`,
+		`{"id": 2, "name": "assert", "items": [{"id": 3}]}`,
+		IgnoreJSONPaths("/id", "/items/*/id")))
+
+	output = strings.ReplaceAll(bufT.buf.String(), `\"`, `"`)
+	Contains(t, output, "Expected JSON are NOT equal, 1 mismatch(es):")
+	Contains(t, output, `+  "name": "assert"`)
+	NotContains(t, output, `"id"`)
+}
+
+func Test_EqualJSONPlaceholders(t *testing.T) {
+	mockT := new(testing.T)
+
+	// NOTE: placeholders are matched only by JSONSubset
+	False(t, EqualJSON(mockT, `{"a": "<<PRESENCE>>", "b": "<<ANY_NUMBER>>"}`, `{"a": 5, "b": 7}`))
+	True(t, EqualJSON(mockT, `{"a": "<<PRESENCE>>"}`, `{"a": "<<PRESENCE>>"}`))
+	False(t, EqualJSONWith(mockT, `{"a": "<<ANY_STRING>>"}`, `{"a": "golib"}`))
+	False(t, ContainsJSON(mockT, `{"user": {"id": 1}}`, "user", json.RawMessage(`{"id": "<<ANY_NUMBER>>"}`)))
+	True(t, JSONSubset(mockT, `{"a": "<<PRESENCE>>", "b": "<<ANY_NUMBER>>"}`, `{"a": 5, "b": 7}`))
+}
+
+func Test_EqualJSONDiff(t *testing.T) {
+	True(t, EqualJSON(t, `{"n": 1, "f": 1.50}`, `{"f": 1.5, "n": 1.0}`))
+
+	bufT := &bufferT{}
+	False(t, EqualJSON(bufT, `{"foo": "bar"}`, `{"foo": "baz", "hello": "world"}`))
+
+	output := strings.ReplaceAll(bufT.buf.String(), `\"`, `"`)
+	Contains(t, output, `/foo: expected "bar", but got "baz"`)
+	Contains(t, output, `/hello: unexpected, got "world"`)
+	NotContains(t, output, "map[string]interface")
+}
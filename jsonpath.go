@@ -0,0 +1,808 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonNode is a value of decoded JSON with its normalized path, i.e. $.items[0].id.
+type jsonNode struct {
+	path  string
+	value any
+}
+
+// errJSONPathNotFound reports a JSON Pointer or JSONPath which matches nothing,
+// with the deepest segment resolved.
+type errJSONPathNotFound struct {
+	path     string
+	segment  string
+	resolved string
+}
+
+func (e *errJSONPathNotFound) Error() string {
+	return fmt.Sprintf("no value matches %q of %q, deepest resolved: %q", e.segment, e.path, e.resolved)
+}
+
+var identifierKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// isJSONQuery returns true if the key is a JSON Pointer (/foo/1) or JSONPath ($.foo[1]).
+// Other keys starting with $, i.e. $schema, are dotted keys.
+func isJSONQuery(key string) bool {
+	return strings.HasPrefix(key, "/") ||
+		key == "$" || strings.HasPrefix(key, "$.") || strings.HasPrefix(key, "$[")
+}
+
+// isJSONPointerNotFound returns true if the key is a JSON Pointer which resolves nothing. Keys starting
+// with "/" were dotted keys, i.e. {"/api": 1}, which are looked up as dotted keys in the case.
+func isJSONPointerNotFound(key string, err error) bool {
+	var notFound *errJSONPathNotFound
+
+	return strings.HasPrefix(key, "/") && errors.As(err, &notFound)
+}
+
+// decodeJSON decodes s with numbers kept as json.Number.
+func decodeJSON(s string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, errors.New("invalid character after top-level value")
+	}
+
+	return value, nil
+}
+
+// queryJSON returns all values of s matched by the JSON Pointer or JSONPath.
+// It returns *errJSONPathNotFound if nothing matched.
+func queryJSON(s, path string) ([]jsonNode, error) {
+	root, err := decodeJSON(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(path, "/") {
+		node, err := resolveJSONPointer(root, path)
+		if err != nil {
+			return nil, err
+		}
+
+		return []jsonNode{node}, nil
+	}
+
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return evalJSONPath(root, path, segments)
+}
+
+// getJSONQueryValue returns value matched by the JSON Pointer or JSONPath in the form of getJsonValue,
+// the path must match exactly one value.
+func getJSONQueryValue(s, path string) ([]byte, error) {
+	nodes, err := queryJSON(s, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) != 1 {
+		return nil, fmt.Errorf("json path %q matches %d values, expected exactly one", path, len(nodes))
+	}
+
+	return jsonNodeBytes(nodes[0].value)
+}
+
+// jsonNodeBytes returns string as is and JSON encoding of others.
+func jsonNodeBytes(value any) ([]byte, error) {
+	if s, ok := value.(string); ok {
+		return []byte(s), nil
+	}
+
+	return json.Marshal(value)
+}
+
+// formatJSONNodes returns paths and values of nodes line by line.
+func formatJSONNodes(nodes []jsonNode) string {
+	lines := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		lines = append(lines, fmt.Sprintf("%s: %s", node.path, formatJSONValue(node.value)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// normalizeJSON converts json.Number of value to float64, which is the same as json.Unmarshal.
+func normalizeJSON(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return v.String()
+		}
+
+		return f
+
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			m[key] = normalizeJSON(item)
+		}
+
+		return m
+
+	case []any:
+		list := make([]any, len(v))
+		for i, item := range v {
+			list[i] = normalizeJSON(item)
+		}
+
+		return list
+	}
+
+	return value
+}
+
+// isJSONNodeEqual returns true if JSON encoding of the value is equal to the node.
+func isJSONNodeEqual(node, value any) bool {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+
+	var expected any
+	if err := json.Unmarshal(data, &expected); err != nil {
+		return false
+	}
+
+	return AreEqualObjects(expected, normalizeJSON(node))
+}
+
+// resolveJSONPointer resolves RFC 6901 JSON Pointer.
+func resolveJSONPointer(root any, pointer string) (jsonNode, error) {
+	node := jsonNode{path: "", value: root}
+
+	for _, token := range strings.Split(pointer, "/")[1:] {
+		key := strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		notFound := &errJSONPathNotFound{
+			path:     pointer,
+			segment:  "/" + token,
+			resolved: node.path,
+		}
+
+		switch v := node.value.(type) {
+		case map[string]any:
+			value, ok := v[key]
+			if !ok {
+				return jsonNode{}, notFound
+			}
+
+			node = jsonNode{node.path + "/" + token, value}
+
+		case []any:
+			// NOTE: leading zeros are not allowed by RFC 6901
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) || (len(key) > 1 && key[0] == '0') {
+				return jsonNode{}, notFound
+			}
+
+			node = jsonNode{node.path + "/" + token, v[i]}
+
+		default:
+			return jsonNode{}, notFound
+		}
+	}
+
+	return node, nil
+}
+
+// jsonPathSegment is a segment of JSONPath, i.e. .foo, [0], [*], ..name and [?(@.age>30)].
+type jsonPathSegment struct {
+	raw       string
+	recursive bool
+	wildcard  bool
+	names     []string
+	indices   []int
+	slice     *jsonPathSlice
+	filter    *jsonPathFilter
+}
+
+type jsonPathSlice struct {
+	start, end *int
+}
+
+// parseJSONPath parses a practical subset of JSONPath, including:
+//
+//	$.store.book       dot notation
+//	$['store']['book'] bracket notation
+//	$.items[*].id      wildcard
+//	$..name            recursive descent
+//	$.items[0,-1]      indices, negative one counts from the end
+//	$.items[1:3]       slice
+//	$.users[?(@.age>30 && @.name!='foo')] filter
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid json path %q, it must start with $", path)
+	}
+
+	var segments []jsonPathSegment
+	for i := 1; i < len(path); {
+		start := i
+
+		var segment jsonPathSegment
+		if strings.HasPrefix(path[i:], "..") {
+			segment.recursive = true
+			i += 2
+		} else if path[i] == '.' {
+			i++
+		} else if path[i] != '[' {
+			return nil, fmt.Errorf("invalid json path %q, unexpected %q at %d", path, path[i], i)
+		}
+
+		switch {
+		case i < len(path) && path[i] == '[':
+			end := findJSONPathBracket(path, i)
+			if end < 0 {
+				return nil, fmt.Errorf("invalid json path %q, unclosed bracket at %d", path, i)
+			}
+
+			if err := segment.parseBracket(path[i+1 : end]); err != nil {
+				return nil, fmt.Errorf("invalid json path %q, %v", path, err)
+			}
+
+			i = end + 1
+
+		case i < len(path) && path[i] == '*':
+			segment.wildcard = true
+			i++
+
+		default:
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+
+			if end == i {
+				return nil, fmt.Errorf("invalid json path %q, missing name at %d", path, i)
+			}
+
+			segment.names = []string{path[i:end]}
+			i = end
+		}
+
+		segment.raw = path[start:i]
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// findJSONPathBracket returns index of the bracket closing the one at start, or -1 if not found.
+func findJSONPathBracket(path string, start int) int {
+	depth := 0
+	for i := start; i < len(path); i++ {
+		switch path[i] {
+		case '\'', '"':
+			quote := path[i]
+			for i++; i < len(path) && path[i] != quote; i++ {
+				if path[i] == '\\' {
+					i++
+				}
+			}
+
+		case '[', '(':
+			depth++
+
+		case ']', ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func (s *jsonPathSegment) parseBracket(selector string) error {
+	selector = strings.TrimSpace(selector)
+
+	switch {
+	case selector == "*":
+		s.wildcard = true
+
+	case strings.HasPrefix(selector, "?"):
+		expr := strings.TrimSpace(selector[1:])
+		if strings.HasPrefix(expr, "(") && strings.HasSuffix(expr, ")") {
+			expr = expr[1 : len(expr)-1]
+		}
+
+		filter, err := parseJSONPathFilter(expr)
+		if err != nil {
+			return err
+		}
+
+		s.filter = filter
+
+	case !strings.ContainsAny(selector, `'"`) && strings.Contains(selector, ":"):
+		parts := strings.Split(selector, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("unsupported slice [%s]", selector)
+		}
+
+		s.slice = &jsonPathSlice{}
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return fmt.Errorf("invalid slice [%s]", selector)
+			}
+
+			if i == 0 {
+				s.slice.start = &n
+			} else {
+				s.slice.end = &n
+			}
+		}
+
+	default:
+		for _, item := range splitJSONPathUnion(selector) {
+			item = strings.TrimSpace(item)
+
+			if name, ok := unquoteJSONPathString(item); ok {
+				s.names = append(s.names, name)
+				continue
+			}
+
+			n, err := strconv.Atoi(item)
+			if err != nil {
+				return fmt.Errorf("invalid selector [%s]", selector)
+			}
+
+			s.indices = append(s.indices, n)
+		}
+	}
+
+	return nil
+}
+
+// splitJSONPathUnion splits selector by commas outside quotes.
+func splitJSONPathUnion(selector string) []string {
+	var (
+		items []string
+		quote byte
+		start int
+	)
+	for i := 0; i < len(selector); i++ {
+		switch c := selector[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+
+		case c == '\'' || c == '"':
+			quote = c
+
+		case c == ',':
+			items = append(items, selector[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(items, selector[start:])
+}
+
+// unquoteJSONPathString returns content of 'single' or "double" quoted string.
+func unquoteJSONPathString(s string) (string, bool) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", false
+	}
+
+	quote := s[0]
+
+	var out strings.Builder
+	for i := 1; i < len(s)-1; i++ {
+		if s[i] == '\\' && i+1 < len(s)-1 {
+			i++
+		} else if s[i] == quote {
+			return "", false
+		}
+
+		out.WriteByte(s[i])
+	}
+
+	return out.String(), true
+}
+
+// evalJSONPath applies segments to the root one by one.
+func evalJSONPath(root any, path string, segments []jsonPathSegment) ([]jsonNode, error) {
+	nodes := []jsonNode{{path: "$", value: root}}
+
+	resolved := "$"
+	for _, segment := range segments {
+		nodes = segment.apply(nodes)
+		if len(nodes) == 0 {
+			return nil, &errJSONPathNotFound{
+				path:     path,
+				segment:  segment.raw,
+				resolved: resolved,
+			}
+		}
+
+		resolved += segment.raw
+	}
+
+	return nodes, nil
+}
+
+func (s *jsonPathSegment) apply(nodes []jsonNode) []jsonNode {
+	var matches []jsonNode
+	for _, node := range nodes {
+		if !s.recursive {
+			matches = append(matches, s.selectNodes(node)...)
+			continue
+		}
+
+		walkJSONNodes(node, func(descendant jsonNode) {
+			matches = append(matches, s.selectNodes(descendant)...)
+		})
+	}
+
+	return matches
+}
+
+// selectNodes returns children of the node matched by the segment.
+func (s *jsonPathSegment) selectNodes(node jsonNode) []jsonNode {
+	switch {
+	case s.wildcard:
+		return childJSONNodes(node)
+
+	case s.filter != nil:
+		var matches []jsonNode
+		for _, child := range childJSONNodes(node) {
+			if s.filter.match(child.value) {
+				matches = append(matches, child)
+			}
+		}
+
+		return matches
+	}
+
+	var matches []jsonNode
+	switch v := node.value.(type) {
+	case map[string]any:
+		for _, name := range s.names {
+			if value, ok := v[name]; ok {
+				matches = append(matches, jsonNode{jsonPathKey(node.path, name), value})
+			}
+		}
+
+	case []any:
+		for _, i := range s.indices {
+			if i < 0 {
+				i += len(v)
+			}
+
+			if i >= 0 && i < len(v) {
+				matches = append(matches, jsonNode{fmt.Sprintf("%s[%d]", node.path, i), v[i]})
+			}
+		}
+
+		if s.slice != nil {
+			start, end := 0, len(v)
+			if s.slice.start != nil {
+				start = clampJSONPathIndex(*s.slice.start, len(v))
+			}
+			if s.slice.end != nil {
+				end = clampJSONPathIndex(*s.slice.end, len(v))
+			}
+
+			for i := start; i < end; i++ {
+				matches = append(matches, jsonNode{fmt.Sprintf("%s[%d]", node.path, i), v[i]})
+			}
+		}
+	}
+
+	return matches
+}
+
+func clampJSONPathIndex(i, n int) int {
+	if i < 0 {
+		i += n
+	}
+
+	return max(0, min(i, n))
+}
+
+// childJSONNodes returns elements of array, or values of object sorted by keys.
+func childJSONNodes(node jsonNode) []jsonNode {
+	switch v := node.value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		children := make([]jsonNode, 0, len(keys))
+		for _, key := range keys {
+			children = append(children, jsonNode{jsonPathKey(node.path, key), v[key]})
+		}
+
+		return children
+
+	case []any:
+		children := make([]jsonNode, 0, len(v))
+		for i, item := range v {
+			children = append(children, jsonNode{fmt.Sprintf("%s[%d]", node.path, i), item})
+		}
+
+		return children
+	}
+
+	return nil
+}
+
+// walkJSONNodes calls fn with the node and all descendants of it in pre-order.
+func walkJSONNodes(node jsonNode, fn func(node jsonNode)) {
+	fn(node)
+
+	for _, child := range childJSONNodes(node) {
+		walkJSONNodes(child, fn)
+	}
+}
+
+func jsonPathKey(path, key string) string {
+	if identifierKey.MatchString(key) {
+		return path + "." + key
+	}
+
+	return fmt.Sprintf("%s[%s]", path, strconv.Quote(key))
+}
+
+// jsonPathFilter is a filter expression of JSONPath, operands of comparison are
+// relative paths (@.age) or literals (30, 'foo', true, null). Comparisons are
+// combined with && and ||, and && binds tighter.
+type jsonPathFilter struct {
+	or [][]jsonPathComparison
+}
+
+type jsonPathComparison struct {
+	left, right *jsonPathOperand
+	op          string
+}
+
+type jsonPathOperand struct {
+	path     []jsonPathSegment
+	relative bool
+	literal  any
+}
+
+var jsonPathFilterToken = regexp.MustCompile(`^\s*(&&|\|\||==|!=|<=|>=|<|>|'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*"|@(?:\[[^\]]*\]|[^\s=!<>&|\[])*|[^\s=!<>&|]+)`)
+
+func parseJSONPathFilter(expr string) (*jsonPathFilter, error) {
+	var tokens []string
+	for rest := strings.TrimSpace(expr); rest != ""; rest = strings.TrimSpace(rest) {
+		match := jsonPathFilterToken.FindStringSubmatch(rest)
+		if match == nil {
+			return nil, fmt.Errorf("invalid filter %q", expr)
+		}
+
+		tokens = append(tokens, match[1])
+		rest = rest[len(match[0]):]
+	}
+
+	filter := &jsonPathFilter{
+		or: [][]jsonPathComparison{nil},
+	}
+	for i := 0; i < len(tokens); {
+		left, err := parseJSONPathOperand(tokens[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter %q, %v", expr, err)
+		}
+
+		comparison := jsonPathComparison{left: left}
+		i++
+
+		if i < len(tokens) && isJSONPathComparator(tokens[i]) {
+			if i+1 >= len(tokens) {
+				return nil, fmt.Errorf("invalid filter %q, missing operand after %s", expr, tokens[i])
+			}
+
+			right, err := parseJSONPathOperand(tokens[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter %q, %v", expr, err)
+			}
+
+			comparison.op = tokens[i]
+			comparison.right = right
+			i += 2
+		}
+
+		last := len(filter.or) - 1
+		filter.or[last] = append(filter.or[last], comparison)
+
+		if i < len(tokens) {
+			switch tokens[i] {
+			case "&&":
+			case "||":
+				filter.or = append(filter.or, nil)
+			default:
+				return nil, fmt.Errorf("invalid filter %q, unexpected %s", expr, tokens[i])
+			}
+
+			i++
+			if i >= len(tokens) {
+				return nil, fmt.Errorf("invalid filter %q, missing operand", expr)
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+func isJSONPathComparator(token string) bool {
+	switch token {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+
+	return false
+}
+
+func parseJSONPathOperand(token string) (*jsonPathOperand, error) {
+	if strings.HasPrefix(token, "@") {
+		path, err := parseJSONPath("$" + token[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		return &jsonPathOperand{path: path, relative: true}, nil
+	}
+
+	if s, ok := unquoteJSONPathString(token); ok {
+		return &jsonPathOperand{literal: s}, nil
+	}
+
+	switch token {
+	case "true":
+		return &jsonPathOperand{literal: true}, nil
+	case "false":
+		return &jsonPathOperand{literal: false}, nil
+	case "null":
+		return &jsonPathOperand{literal: nil}, nil
+	}
+
+	f, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported operand %s", token)
+	}
+
+	return &jsonPathOperand{literal: f}, nil
+}
+
+// value returns value of the operand for current node, ok is false if the relative path matches nothing.
+func (o *jsonPathOperand) value(current any) (value any, ok bool) {
+	if !o.relative {
+		return o.literal, true
+	}
+
+	nodes, err := evalJSONPath(current, "@", o.path)
+	if err != nil {
+		return nil, false
+	}
+
+	return normalizeJSON(nodes[0].value), true
+}
+
+func (f *jsonPathFilter) match(current any) bool {
+	for _, and := range f.or {
+		matched := true
+		for _, comparison := range and {
+			if !comparison.match(current) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *jsonPathComparison) match(current any) bool {
+	left, leftOK := c.left.value(current)
+	if c.right == nil {
+		return leftOK && (c.left.relative || isJSONTruthy(left))
+	}
+
+	right, rightOK := c.right.value(current)
+
+	switch c.op {
+	case "==":
+		return leftOK == rightOK && (!leftOK || AreEqualObjects(left, right))
+	case "!=":
+		return leftOK != rightOK || (leftOK && !AreEqualObjects(left, right))
+	}
+
+	if !leftOK || !rightOK {
+		return false
+	}
+
+	var order int
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok || math.IsNaN(l) || math.IsNaN(r) {
+			return false
+		}
+
+		order = compareJSONOrder(l < r, l > r)
+
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return false
+		}
+
+		order = strings.Compare(l, r)
+
+	default:
+		return false
+	}
+
+	switch c.op {
+	case "<":
+		return order < 0
+	case "<=":
+		return order <= 0
+	case ">":
+		return order > 0
+	case ">=":
+		return order >= 0
+	}
+
+	return false
+}
+
+func compareJSONOrder(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+
+	return 0
+}
+
+func isJSONTruthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+)
+
+const jsonPathDocument = `{
+	"store": {
+		"name": "golib",
+		"a.b": "dotted",
+		"a/b": "slashed",
+		"items": [
+			{"id": 1, "name": "foo", "price": 8.5, "tags": ["new"]},
+			{"id": 2, "name": "bar", "price": 12},
+			{"id": 3, "name": "baz", "price": 30, "tags": []}
+		]
+	},
+	"users": [
+		{"name": "alice", "age": 31, "admin": true},
+		{"name": "bob", "age": 25},
+		{"name": "carol", "age": 42, "admin": false}
+	]
+}`
+
+func Test_queryJSON(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{"/store/name", `/store/name: "golib"`},
+		{"/store/a~1b", `/store/a~1b: "slashed"`},
+		{"/store/items/1/id", `/store/items/1/id: 2`},
+		{"$.store.name", `$.store.name: "golib"`},
+		{"$['store']['a.b']", `$.store["a.b"]: "dotted"`},
+		{"$.store.items[*].id", "$.store.items[0].id: 1\n$.store.items[1].id: 2\n$.store.items[2].id: 3"},
+		{"$.store.items[-1].name", `$.store.items[2].name: "baz"`},
+		{"$.store.items[0,2].id", "$.store.items[0].id: 1\n$.store.items[2].id: 3"},
+		{"$.store.items[1:].id", "$.store.items[1].id: 2\n$.store.items[2].id: 3"},
+		{"$.store.items[:-2].id", "$.store.items[0].id: 1"},
+		{"$..age", "$.users[0].age: 31\n$.users[1].age: 25\n$.users[2].age: 42"},
+		{"$.store.*.id", ""},
+		{"$.users[?(@.age>30)].name", "$.users[0].name: \"alice\"\n$.users[2].name: \"carol\""},
+		{"$.users[?(@.age>30 && @.admin==false)].name", `$.users[2].name: "carol"`},
+		{"$.users[?(@.age<30 || @.name=='alice')].name", "$.users[0].name: \"alice\"\n$.users[1].name: \"bob\""},
+		{"$.users[?(@.admin)].name", "$.users[0].name: \"alice\"\n$.users[2].name: \"carol\""},
+		{"$.users[?(@.admin!=true)].name", "$.users[1].name: \"bob\"\n$.users[2].name: \"carol\""},
+		{"$.store.items[?(@.tags)].id", "$.store.items[0].id: 1\n$.store.items[2].id: 3"},
+		{"$..items[?(@.price>=12)].name", "$.store.items[1].name: \"bar\"\n$.store.items[2].name: \"baz\""},
+	}
+	for _, tc := range testCases {
+		nodes, err := queryJSON(jsonPathDocument, tc.path)
+		if tc.expected == "" {
+			IsError(t, err, tc.path)
+			continue
+		}
+
+		if NotError(t, err, tc.path) {
+			Equal(t, tc.expected, formatJSONNodes(nodes), tc.path)
+		}
+	}
+}
+
+func Test_queryJSONNotFound(t *testing.T) {
+	testCases := []struct {
+		path     string
+		segment  string
+		resolved string
+	}{
+		{"/store/items/5/id", "/5", "/store/items"},
+		{"/store/items/01", "/01", "/store/items"},
+		{"/users/0/name/first", "/first", "/users/0/name"},
+		{"$.store.items[5].id", "[5]", "$.store.items"},
+		{"$.users[?(@.age>50)].name", "[?(@.age>50)]", "$.users"},
+		{"$.store.missing.id", ".missing", "$.store"},
+	}
+	for _, tc := range testCases {
+		_, err := queryJSON(jsonPathDocument, tc.path)
+
+		var notFound *errJSONPathNotFound
+		if True(t, errors.As(err, &notFound), tc.path) {
+			Equal(t, tc.segment, notFound.segment, tc.path)
+			Equal(t, tc.resolved, notFound.resolved, tc.path)
+		}
+	}
+}
+
+func Test_parseJSONPath(t *testing.T) {
+	for _, path := range []string{
+		"store.name",
+		"$store",
+		"$.store[0",
+		"$.store[1:2:3]",
+		"$.store[abc]",
+		"$.users[?(@.age>)]",
+		"$.users[?(@.age>30 &&)]",
+		"$.users[?(@.age 30)]",
+	} {
+		_, err := parseJSONPath(path)
+		IsError(t, err, path)
+	}
+}
@@ -0,0 +1,777 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxJSONSchemaDepth limits nested $ref, which avoids validating cyclic schemas endlessly.
+const maxJSONSchemaDepth = 256
+
+// JSONSchemaFile refers a JSON Schema file in fs.FS, i.e. embed.FS or os.DirFS.
+// Relative $ref to other files are resolved within the same fs.FS.
+type JSONSchemaFile struct {
+	FS   fs.FS
+	Name string
+}
+
+// jsonSchemaViolation is a failed validation with instance path and schema path in JSON Pointer.
+type jsonSchemaViolation struct {
+	instancePath string
+	schemaPath   string
+	message      string
+}
+
+func (v jsonSchemaViolation) String() string {
+	instancePath := v.instancePath
+	if instancePath == "" {
+		instancePath = "(root)"
+	}
+
+	return fmt.Sprintf("%s: %s (schema: %s)", instancePath, v.message, v.schemaPath)
+}
+
+// jsonSchemaValidator validates JSON with a subset of JSON Schema draft 2020-12.
+type jsonSchemaValidator struct {
+	fsys fs.FS
+	docs map[string]any
+}
+
+// newJSONSchemaValidator loads schema of string, []byte, json.RawMessage or JSONSchemaFile,
+// and returns name of the root document.
+func newJSONSchemaValidator(schema any) (*jsonSchemaValidator, string, error) {
+	v := &jsonSchemaValidator{
+		docs: map[string]any{},
+	}
+
+	var data []byte
+	switch s := schema.(type) {
+	case string:
+		data = []byte(s)
+
+	case []byte:
+		data = s
+
+	case json.RawMessage:
+		data = s
+
+	case JSONSchemaFile:
+		v.fsys = s.FS
+
+		if _, err := v.loadDocument(s.Name); err != nil {
+			return nil, "", err
+		}
+
+		return v, s.Name, nil
+
+	default:
+		return nil, "", fmt.Errorf("unsupported schema of %T", schema)
+	}
+
+	doc, err := decodeJSON(string(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid schema: %v", err)
+	}
+
+	v.docs[""] = doc
+
+	return v, "", nil
+}
+
+// loadDocument returns decoded schema of the name, which is loaded from fs.FS once.
+func (v *jsonSchemaValidator) loadDocument(name string) (any, error) {
+	if doc, ok := v.docs[name]; ok {
+		return doc, nil
+	}
+
+	if v.fsys == nil {
+		return nil, fmt.Errorf("cannot load schema %q without fs.FS", name)
+	}
+
+	data, err := fs.ReadFile(v.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := decodeJSON(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema %q: %v", name, err)
+	}
+
+	v.docs[name] = doc
+
+	return doc, nil
+}
+
+// resolveRef returns the schema referred by ref from the document, with name of the document containing it.
+func (v *jsonSchemaValidator) resolveRef(docName, ref string) (any, string, error) {
+	file, fragment, _ := strings.Cut(ref, "#")
+	if file != "" {
+		if strings.Contains(file, "://") {
+			return nil, "", fmt.Errorf("remote $ref %q is not supported", ref)
+		}
+
+		docName = path.Join(path.Dir(docName), file)
+	}
+
+	doc, err := v.loadDocument(docName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if fragment == "" {
+		return doc, docName, nil
+	}
+
+	if !strings.HasPrefix(fragment, "/") {
+		return nil, "", fmt.Errorf("$ref %q of anchor is not supported", ref)
+	}
+
+	fragment, err = url.PathUnescape(fragment)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid $ref %q: %v", ref, err)
+	}
+
+	node, err := resolveJSONPointer(doc, fragment)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot resolve $ref %q: %v", ref, err)
+	}
+
+	return node.value, docName, nil
+}
+
+// validate returns all violations of instance against schema. It returns error if schema is invalid.
+func (v *jsonSchemaValidator) validate(docName string, schema any, schemaPath string, instance any, instancePath string, depth int) ([]jsonSchemaViolation, error) {
+	if depth > maxJSONSchemaDepth {
+		return nil, fmt.Errorf("$ref nested too deep at %s", schemaPath)
+	}
+
+	var violations []jsonSchemaViolation
+	report := func(keyword, format string, args ...any) {
+		violations = append(violations, jsonSchemaViolation{
+			instancePath: instancePath,
+			schemaPath:   schemaPath + "/" + keyword,
+			message:      fmt.Sprintf(format, args...),
+		})
+	}
+
+	keywords, ok := schema.(map[string]any)
+	if !ok {
+		allowed, ok := schema.(bool)
+		if !ok {
+			return nil, fmt.Errorf("invalid schema at %s, it must be an object or a boolean", schemaPath)
+		}
+
+		if !allowed {
+			violations = append(violations, jsonSchemaViolation{
+				instancePath: instancePath,
+				schemaPath:   schemaPath,
+				message:      "is not allowed by false schema",
+			})
+		}
+
+		return violations, nil
+	}
+
+	// subSchema validates instance against schema of the keyword, with optional suffix of schema path.
+	subSchema := func(keyword string, sub any, suffix string, instance any, instancePath string) ([]jsonSchemaViolation, error) {
+		return v.validate(docName, sub, schemaPath+"/"+keyword+suffix, instance, instancePath, depth)
+	}
+
+	if ref, ok := keywords["$ref"].(string); ok {
+		target, targetDoc, err := v.resolveRef(docName, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		refViolations, err := v.validate(targetDoc, target, schemaPath+"/$ref", instance, instancePath, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		violations = append(violations, refViolations...)
+	}
+
+	if types, ok := keywords["type"]; ok {
+		var names []string
+		switch t := types.(type) {
+		case string:
+			names = []string{t}
+
+		case []any:
+			for _, name := range t {
+				if s, ok := name.(string); ok {
+					names = append(names, s)
+				}
+			}
+		}
+
+		matched := false
+		for _, name := range names {
+			if isJSONSchemaType(instance, name) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			report("type", "expected %s, but got %s", strings.Join(names, " or "), jsonSchemaTypeOf(instance))
+		}
+	}
+
+	if values, ok := keywords["enum"].([]any); ok {
+		matched := false
+		for _, value := range values {
+			if isJSONSchemaEqual(value, instance) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			report("enum", "%s is not one of %s", formatJSONValue(instance), formatJSONValue(values))
+		}
+	}
+
+	if value, ok := keywords["const"]; ok && !isJSONSchemaEqual(value, instance) {
+		report("const", "%s is not equal to %s", formatJSONValue(instance), formatJSONValue(value))
+	}
+
+	// number
+	if number, ok := instance.(json.Number); ok {
+		value, _ := number.Float64()
+
+		if minimum, ok := jsonSchemaNumber(keywords["minimum"]); ok && value < minimum {
+			report("minimum", "%s is less than minimum %v", number, minimum)
+		}
+
+		if maximum, ok := jsonSchemaNumber(keywords["maximum"]); ok && value > maximum {
+			report("maximum", "%s is greater than maximum %v", number, maximum)
+		}
+
+		if minimum, ok := jsonSchemaNumber(keywords["exclusiveMinimum"]); ok && value <= minimum {
+			report("exclusiveMinimum", "%s is less than or equal to exclusive minimum %v", number, minimum)
+		}
+
+		if maximum, ok := jsonSchemaNumber(keywords["exclusiveMaximum"]); ok && value >= maximum {
+			report("exclusiveMaximum", "%s is greater than or equal to exclusive maximum %v", number, maximum)
+		}
+
+		if divisor, ok := keywords["multipleOf"].(json.Number); ok && !isJSONMultipleOf(number, divisor) {
+			report("multipleOf", "%s is not a multiple of %s", number, divisor)
+		}
+	}
+
+	// string
+	if str, ok := instance.(string); ok {
+		length := utf8.RuneCountInString(str)
+
+		if minLength, ok := jsonSchemaNumber(keywords["minLength"]); ok && float64(length) < minLength {
+			report("minLength", "length %d is less than minLength %v", length, minLength)
+		}
+
+		if maxLength, ok := jsonSchemaNumber(keywords["maxLength"]); ok && float64(length) > maxLength {
+			report("maxLength", "length %d is greater than maxLength %v", length, maxLength)
+		}
+
+		if pattern, ok := keywords["pattern"].(string); ok {
+			reg, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q at %s: %v", pattern, schemaPath, err)
+			}
+
+			if !reg.MatchString(str) {
+				report("pattern", "%q does not match pattern %q", str, pattern)
+			}
+		}
+
+		if format, ok := keywords["format"].(string); ok {
+			if err := checkJSONSchemaFormat(format, str); err != nil {
+				report("format", "%q is not a valid %s: %v", str, format, err)
+			}
+		}
+	}
+
+	// array
+	if items, ok := instance.([]any); ok {
+		if minItems, ok := jsonSchemaNumber(keywords["minItems"]); ok && float64(len(items)) < minItems {
+			report("minItems", "%d item(s) is less than minItems %v", len(items), minItems)
+		}
+
+		if maxItems, ok := jsonSchemaNumber(keywords["maxItems"]); ok && float64(len(items)) > maxItems {
+			report("maxItems", "%d item(s) is greater than maxItems %v", len(items), maxItems)
+		}
+
+		if unique, ok := keywords["uniqueItems"].(bool); ok && unique {
+		unique:
+			for i := range items {
+				for j := 0; j < i; j++ {
+					if isJSONSchemaEqual(items[i], items[j]) {
+						report("uniqueItems", "items at %d and %d are equal", j, i)
+						break unique
+					}
+				}
+			}
+		}
+
+		prefixItems, _ := keywords["prefixItems"].([]any)
+		for i, sub := range prefixItems {
+			if i >= len(items) {
+				break
+			}
+
+			itemViolations, err := subSchema("prefixItems", sub, "/"+strconv.Itoa(i), items[i], instancePath+"/"+strconv.Itoa(i))
+			if err != nil {
+				return nil, err
+			}
+
+			violations = append(violations, itemViolations...)
+		}
+
+		if sub, ok := keywords["items"]; ok {
+			for i := len(prefixItems); i < len(items); i++ {
+				itemViolations, err := subSchema("items", sub, "", items[i], instancePath+"/"+strconv.Itoa(i))
+				if err != nil {
+					return nil, err
+				}
+
+				violations = append(violations, itemViolations...)
+			}
+		}
+
+		if sub, ok := keywords["contains"]; ok {
+			matched := false
+			for i := range items {
+				itemViolations, err := subSchema("contains", sub, "", items[i], instancePath+"/"+strconv.Itoa(i))
+				if err != nil {
+					return nil, err
+				}
+
+				if len(itemViolations) == 0 {
+					matched = true
+					break
+				}
+			}
+
+			if !matched {
+				report("contains", "no item matches contains schema")
+			}
+		}
+	}
+
+	// object
+	if object, ok := instance.(map[string]any); ok {
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		if required, ok := keywords["required"].([]any); ok {
+			for _, name := range required {
+				if s, ok := name.(string); ok {
+					if _, ok := object[s]; !ok {
+						report("required", "missing required property %q", s)
+					}
+				}
+			}
+		}
+
+		if minProperties, ok := jsonSchemaNumber(keywords["minProperties"]); ok && float64(len(object)) < minProperties {
+			report("minProperties", "%d properties is less than minProperties %v", len(object), minProperties)
+		}
+
+		if maxProperties, ok := jsonSchemaNumber(keywords["maxProperties"]); ok && float64(len(object)) > maxProperties {
+			report("maxProperties", "%d properties is greater than maxProperties %v", len(object), maxProperties)
+		}
+
+		properties, _ := keywords["properties"].(map[string]any)
+		patternProperties, _ := keywords["patternProperties"].(map[string]any)
+		additional, hasAdditional := keywords["additionalProperties"]
+
+		patterns := make([]string, 0, len(patternProperties))
+		for pattern := range patternProperties {
+			patterns = append(patterns, pattern)
+		}
+		sort.Strings(patterns)
+
+		for _, name := range names {
+			propertyPath := instancePath + "/" + escapeJSONPointer(name)
+
+			matched := false
+			if sub, ok := properties[name]; ok {
+				matched = true
+
+				propertyViolations, err := subSchema("properties", sub, "/"+escapeJSONPointer(name), object[name], propertyPath)
+				if err != nil {
+					return nil, err
+				}
+
+				violations = append(violations, propertyViolations...)
+			}
+
+			for _, pattern := range patterns {
+				reg, err := regexp.Compile(pattern)
+				if err != nil {
+					return nil, fmt.Errorf("invalid pattern %q at %s: %v", pattern, schemaPath, err)
+				}
+
+				if !reg.MatchString(name) {
+					continue
+				}
+
+				matched = true
+
+				propertyViolations, err := subSchema("patternProperties", patternProperties[pattern], "/"+escapeJSONPointer(pattern), object[name], propertyPath)
+				if err != nil {
+					return nil, err
+				}
+
+				violations = append(violations, propertyViolations...)
+			}
+
+			if !matched && hasAdditional {
+				propertyViolations, err := subSchema("additionalProperties", additional, "", object[name], propertyPath)
+				if err != nil {
+					return nil, err
+				}
+
+				violations = append(violations, propertyViolations...)
+			}
+		}
+	}
+
+	// combinations
+	if subs, ok := keywords["allOf"].([]any); ok {
+		for i, sub := range subs {
+			subViolations, err := subSchema("allOf", sub, "/"+strconv.Itoa(i), instance, instancePath)
+			if err != nil {
+				return nil, err
+			}
+
+			violations = append(violations, subViolations...)
+		}
+	}
+
+	if subs, ok := keywords["anyOf"].([]any); ok {
+		var anyViolations []jsonSchemaViolation
+
+		matched := false
+		for i, sub := range subs {
+			subViolations, err := subSchema("anyOf", sub, "/"+strconv.Itoa(i), instance, instancePath)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(subViolations) == 0 {
+				matched = true
+				break
+			}
+
+			anyViolations = append(anyViolations, subViolations...)
+		}
+
+		if !matched {
+			report("anyOf", "does not match any schema of anyOf")
+
+			violations = append(violations, anyViolations...)
+		}
+	}
+
+	if subs, ok := keywords["oneOf"].([]any); ok {
+		var (
+			matches       []string
+			oneViolations []jsonSchemaViolation
+		)
+		for i, sub := range subs {
+			subViolations, err := subSchema("oneOf", sub, "/"+strconv.Itoa(i), instance, instancePath)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(subViolations) == 0 {
+				matches = append(matches, strconv.Itoa(i))
+			}
+
+			oneViolations = append(oneViolations, subViolations...)
+		}
+
+		switch len(matches) {
+		case 0:
+			report("oneOf", "does not match any schema of oneOf")
+
+			violations = append(violations, oneViolations...)
+
+		case 1:
+			// valid
+
+		default:
+			report("oneOf", "matches more than one schema of oneOf: %s", strings.Join(matches, ", "))
+		}
+	}
+
+	if sub, ok := keywords["not"]; ok {
+		subViolations, err := subSchema("not", sub, "", instance, instancePath)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(subViolations) == 0 {
+			report("not", "is not allowed by not schema")
+		}
+	}
+
+	if sub, ok := keywords["if"]; ok {
+		ifViolations, err := subSchema("if", sub, "", instance, instancePath)
+		if err != nil {
+			return nil, err
+		}
+
+		keyword := "then"
+		if len(ifViolations) > 0 {
+			keyword = "else"
+		}
+
+		if sub, ok := keywords[keyword]; ok {
+			subViolations, err := subSchema(keyword, sub, "", instance, instancePath)
+			if err != nil {
+				return nil, err
+			}
+
+			violations = append(violations, subViolations...)
+		}
+	}
+
+	return violations, nil
+}
+
+// isJSONSchemaType returns true if instance is of the JSON Schema type.
+func isJSONSchemaType(instance any, name string) bool {
+	switch name {
+	case "integer":
+		number, ok := instance.(json.Number)
+		if !ok {
+			return false
+		}
+
+		f, ok := new(big.Float).SetString(number.String())
+
+		return ok && f.IsInt()
+
+	case "number":
+		_, ok := instance.(json.Number)
+
+		return ok
+	}
+
+	return jsonSchemaTypeOf(instance) == name
+}
+
+// jsonSchemaTypeOf returns JSON Schema type of the instance, and integer is reported as number.
+func jsonSchemaTypeOf(instance any) string {
+	switch instance.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+
+	return fmt.Sprintf("%T", instance)
+}
+
+func isJSONSchemaEqual(expected, actual any) bool {
+	return AreEqualObjects(normalizeJSON(expected), normalizeJSON(actual))
+}
+
+func jsonSchemaNumber(value any) (float64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+
+	f, err := number.Float64()
+
+	return f, err == nil
+}
+
+// isJSONMultipleOf checks with rational numbers, which avoids rounding error of floats, i.e. 0.3 of 0.1.
+func isJSONMultipleOf(number, divisor json.Number) bool {
+	n, ok := new(big.Rat).SetString(number.String())
+	if !ok {
+		return false
+	}
+
+	d, ok := new(big.Rat).SetString(divisor.String())
+	if !ok || d.Sign() == 0 {
+		return false
+	}
+
+	return new(big.Rat).Quo(n, d).IsInt()
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+var (
+	jsonSchemaUUID     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	jsonSchemaHostname = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`)
+)
+
+// checkJSONSchemaFormat validates well known formats, unknown formats are ignored.
+func checkJSONSchemaFormat(format, value string) error {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339Nano, value)
+
+		return err
+
+	case "date":
+		_, err := time.Parse(time.DateOnly, value)
+
+		return err
+
+	case "time":
+		if _, err := time.Parse("15:04:05Z07:00", value); err != nil {
+			_, err = time.Parse("15:04:05.999999999Z07:00", value)
+
+			return err
+		}
+
+	case "email":
+		addr, err := mail.ParseAddress(value)
+		if err != nil {
+			return err
+		}
+
+		if addr.Address != value {
+			return errors.New("unexpected display name")
+		}
+
+	case "hostname":
+		if len(value) > 253 || !jsonSchemaHostname.MatchString(value) {
+			return errors.New("invalid hostname")
+		}
+
+	case "ipv4":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil || strings.Contains(value, ":") {
+			return errors.New("invalid IPv4 address")
+		}
+
+	case "ipv6":
+		if ip := net.ParseIP(value); ip == nil || !strings.Contains(value, ":") {
+			return errors.New("invalid IPv6 address")
+		}
+
+	case "uri":
+		u, err := url.Parse(value)
+		if err != nil {
+			return err
+		}
+
+		if !u.IsAbs() {
+			return errors.New("missing scheme")
+		}
+
+	case "uri-reference":
+		_, err := url.Parse(value)
+
+		return err
+
+	case "uuid":
+		if !jsonSchemaUUID.MatchString(value) {
+			return errors.New("invalid UUID")
+		}
+
+	case "regex":
+		_, err := regexp.Compile(value)
+
+		return err
+	}
+
+	return nil
+}
+
+// validateJSONSchema returns all violations of actual against schema.
+func validateJSONSchema(schema any, actual string) ([]jsonSchemaViolation, error) {
+	v, docName, err := newJSONSchemaValidator(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := decodeJSON(actual)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json: %v", err)
+	}
+
+	return v.validate(docName, v.docs[docName], "#", instance, "", 0)
+}
+
+// MatchesJSONSchema asserts that the actual JSON is valid against the JSON Schema, which is
+// a string, []byte, json.RawMessage or JSONSchemaFile. It supports a subset of draft 2020-12
+// validation, including type, enum, const, numeric and string bounds, pattern, format, items,
+// properties, required, $ref within documents, allOf, anyOf, oneOf, not and if/then/else.
+//
+//	assert.MatchesJSONSchema(t, `{"type": "object", "required": ["id"]}`, `{"id": 1}`)
+//	assert.MatchesJSONSchema(t, assert.JSONSchemaFile{FS: os.DirFS("testdata"), Name: "user.json"}, body)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func MatchesJSONSchema(t Testing, schema any, actual string, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	violations, err := validateJSONSchema(schema, actual)
+	if err != nil {
+		return Fail(t,
+			fmt.Sprintf("Failed to validate with JSON schema: %v", err),
+			formatAndArgs...)
+	}
+
+	if len(violations) > 0 {
+		lines := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			lines = append(lines, violation.String())
+		}
+
+		return Fail(t,
+			fmt.Sprintf("Expected JSON to match schema, but got %d violation(s):\n%s", len(violations), strings.Join(lines, "\n")),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// MatchesJSONSchema asserts that the actual JSON is valid against the JSON Schema.
+//
+//	it.MatchesJSONSchema(`{"type": "object", "required": ["id"]}`, `{"id": 1}`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (it *Assertions) MatchesJSONSchema(schema interface{}, actual string, formatAndArgs ...interface{}) bool {
+	if h, ok := it.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return MatchesJSONSchema(it.t, schema, actual, formatAndArgs...)
+}
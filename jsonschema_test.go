@@ -0,0 +1,175 @@
+package assert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const userJSONSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"required": ["id", "name", "email"],
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"name": {"type": "string", "minLength": 2, "maxLength": 8, "pattern": "^[a-z]+$"},
+		"email": {"type": "string", "format": "email"},
+		"role": {"enum": ["admin", "user"]},
+		"score": {"type": "number", "exclusiveMaximum": 100, "multipleOf": 0.1},
+		"tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true, "maxItems": 3},
+		"address": {"$ref": "#/$defs/address"},
+		"contact": {
+			"oneOf": [
+				{"type": "object", "required": ["phone"]},
+				{"type": "object", "required": ["fax"]}
+			]
+		}
+	},
+	"additionalProperties": false,
+	"$defs": {
+		"address": {
+			"type": "object",
+			"required": ["zip"],
+			"properties": {
+				"zip": {"type": "string", "pattern": "^[0-9]{5}$"}
+			}
+		}
+	}
+}`
+
+func Test_MatchesJSONSchema(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, MatchesJSONSchema(mockT, userJSONSchema, `{
+		"id": 1,
+		"name": "golib",
+		"email": "golib@example.com",
+		"role": "admin",
+		"score": 99.9,
+		"tags": ["a", "b"],
+		"address": {"zip": "10001"},
+		"contact": {"phone": "123"}
+	}`))
+	True(t, MatchesJSONSchema(mockT, []byte(`{"type": ["string", "null"]}`), `null`))
+	True(t, MatchesJSONSchema(mockT, json.RawMessage(`true`), `{"any": "thing"}`))
+
+	violations, err := validateJSONSchema(userJSONSchema, `{
+		"id": 1.5,
+		"name": "G",
+		"email": "Golib <golib@example.com>",
+		"role": "root",
+		"score": 100,
+		"tags": ["a", "a", "b", "c"],
+		"address": {"zip": "ABCDE"},
+		"contact": {"phone": "123", "fax": "456"},
+		"unknown": true
+	}`)
+	if NotError(t, err) {
+		lines := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			lines = append(lines, violation.String())
+		}
+
+		Equal(t, []string{
+			`/address/zip: "ABCDE" does not match pattern "^[0-9]{5}$" (schema: #/properties/address/$ref/properties/zip/pattern)`,
+			`/contact: matches more than one schema of oneOf: 0, 1 (schema: #/properties/contact/oneOf)`,
+			`/email: "Golib <golib@example.com>" is not a valid email: unexpected display name (schema: #/properties/email/format)`,
+			`/id: expected integer, but got number (schema: #/properties/id/type)`,
+			`/name: length 1 is less than minLength 2 (schema: #/properties/name/minLength)`,
+			`/name: "G" does not match pattern "^[a-z]+$" (schema: #/properties/name/pattern)`,
+			`/role: "root" is not one of ["admin","user"] (schema: #/properties/role/enum)`,
+			`/score: 100 is greater than or equal to exclusive maximum 100 (schema: #/properties/score/exclusiveMaximum)`,
+			`/tags: 4 item(s) is greater than maxItems 3 (schema: #/properties/tags/maxItems)`,
+			`/tags: items at 0 and 1 are equal (schema: #/properties/tags/uniqueItems)`,
+			`/unknown: is not allowed by false schema (schema: #/additionalProperties)`,
+		}, lines)
+	}
+
+	bufT := &bufferT{}
+	False(t, MatchesJSONSchema(bufT, userJSONSchema, `{"id": 0}`))
+	Contains(t, bufT.buf.String(), "Expected JSON to match schema, but got 3 violation(s)")
+	Contains(t, bufT.buf.String(), `(root): missing required property "name" (schema: #/required)`)
+	Contains(t, bufT.buf.String(), "/id: 0 is less than minimum 1 (schema: #/properties/id/minimum)")
+}
+
+func Test_MatchesJSONSchemaCombinations(t *testing.T) {
+	mockT := new(testing.T)
+
+	schema := `{
+		"allOf": [{"type": "object"}, {"required": ["kind"]}],
+		"anyOf": [{"properties": {"kind": {"const": "a"}}}, {"properties": {"kind": {"const": "b"}}}],
+		"not": {"required": ["forbidden"]},
+		"if": {"properties": {"kind": {"const": "a"}}},
+		"then": {"required": ["a"]},
+		"else": {"required": ["b"]}
+	}`
+
+	True(t, MatchesJSONSchema(mockT, schema, `{"kind": "a", "a": 1}`))
+	True(t, MatchesJSONSchema(mockT, schema, `{"kind": "b", "b": 1}`))
+	False(t, MatchesJSONSchema(mockT, schema, `{"kind": "a", "b": 1}`))
+	False(t, MatchesJSONSchema(mockT, schema, `{"kind": "c", "b": 1}`))
+	False(t, MatchesJSONSchema(mockT, schema, `{"kind": "b", "b": 1, "forbidden": true}`))
+	False(t, MatchesJSONSchema(mockT, schema, `[]`))
+
+	True(t, MatchesJSONSchema(mockT, `{"prefixItems": [{"type": "integer"}], "items": {"type": "string"}, "contains": {"const": "x"}}`, `[1, "x", "y"]`))
+	False(t, MatchesJSONSchema(mockT, `{"prefixItems": [{"type": "integer"}], "items": {"type": "string"}}`, `[1, 2]`))
+	False(t, MatchesJSONSchema(mockT, `{"contains": {"const": "x"}}`, `["y"]`))
+	True(t, MatchesJSONSchema(mockT, `{"patternProperties": {"^x-": {"type": "string"}}, "additionalProperties": false}`, `{"x-id": "1"}`))
+	False(t, MatchesJSONSchema(mockT, `{"patternProperties": {"^x-": {"type": "string"}}, "additionalProperties": false}`, `{"x-id": 1}`))
+	True(t, MatchesJSONSchema(mockT, `{"multipleOf": 0.1}`, `0.3`))
+}
+
+func Test_MatchesJSONSchemaFormats(t *testing.T) {
+	testCases := []struct {
+		format  string
+		valid   string
+		invalid string
+	}{
+		{"date-time", "2024-01-02T15:04:05Z", "2024-01-02 15:04:05"},
+		{"date", "2024-01-02", "2024-13-02"},
+		{"time", "15:04:05+08:00", "25:04:05Z"},
+		{"hostname", "example.com", "-example.com"},
+		{"ipv4", "127.0.0.1", "::1"},
+		{"ipv6", "::1", "127.0.0.1"},
+		{"uri", "https://example.com/path", "/path"},
+		{"uuid", "123e4567-e89b-12d3-a456-426614174000", "123e4567"},
+		{"regex", "^[a-z]+$", "[a-z"},
+	}
+	for _, tc := range testCases {
+		schema := `{"format": "` + tc.format + `"}`
+
+		True(t, MatchesJSONSchema(t, schema, `"`+tc.valid+`"`), tc.format)
+		False(t, MatchesJSONSchema(new(testing.T), schema, `"`+tc.invalid+`"`), tc.format)
+	}
+
+	True(t, MatchesJSONSchema(t, `{"format": "unknown"}`, `"anything"`))
+}
+
+func Test_MatchesJSONSchemaFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"schemas/user.json":           {Data: []byte(`{"type": "object", "properties": {"address": {"$ref": "common/address.json#/$defs/address"}}}`)},
+		"schemas/common/address.json": {Data: []byte(`{"$defs": {"address": {"type": "object", "required": ["zip"]}}}`)},
+		"schemas/broken.json":         {Data: []byte(`{"$ref": "missing.json"}`)},
+	}
+
+	True(t, MatchesJSONSchema(t, JSONSchemaFile{FS: fsys, Name: "schemas/user.json"}, `{"address": {"zip": "10001"}}`))
+
+	violations, err := validateJSONSchema(JSONSchemaFile{FS: fsys, Name: "schemas/user.json"}, `{"address": {}}`)
+	if NotError(t, err) && Len(t, violations, 1) {
+		Equal(t, `/address: missing required property "zip" (schema: #/properties/address/$ref/required)`, violations[0].String())
+	}
+
+	bufT := &bufferT{}
+	False(t, MatchesJSONSchema(bufT, JSONSchemaFile{FS: fsys, Name: "schemas/broken.json"}, `{}`))
+	Contains(t, bufT.buf.String(), "Failed to validate with JSON schema")
+
+	_, err = validateJSONSchema(`{"$ref": "#/$defs/missing"}`, `{}`)
+	True(t, err != nil && strings.Contains(err.Error(), `cannot resolve $ref "#/$defs/missing"`))
+
+	_, err = validateJSONSchema(`{"$ref": "#"}`, `{}`)
+	IsError(t, err)
+
+	it := New(t)
+	it.MatchesJSONSchema(`{"type": "integer"}`, `1`)
+}
@@ -0,0 +1,429 @@
+// Package match provides composable matchers for assert.That. Every matcher explains why the actual
+// value matches or not, explanations of nested matchers are indented as a tree under their parent.
+//
+//	func TestUser(t *testing.T) {
+//	  assert.That(t, user, match.AllOf(
+//	    match.HasField("Name", match.StartsWith("go")),
+//	    match.HasField("Age", match.Between(18, 60)),
+//	    match.HasField("Tags", match.Each(match.MatchesRegexp(`^[a-z]+$`))),
+//	  ))
+//	}
+package match
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/golib/assert"
+	"github.com/golib/assert/internal/ordered"
+)
+
+// Equals matches the actual equal to the expected, values convertible to the type of each other
+// are equal if their values are equal, see assert.EqualValues.
+func Equals(expected any) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		if !assert.AreEqualValues(expected, actual) {
+			return false, fmt.Sprintf("%#v is NOT equal to %#v", actual, expected)
+		}
+
+		return true, fmt.Sprintf("%#v is equal to %#v", actual, expected)
+	})
+}
+
+// Not matches the actual does NOT match the matcher.
+func Not(matcher assert.Matcher) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		ok, explanation := matcher.Match(actual)
+		if ok {
+			return false, tree("expected NOT to match, but it does:", explanation)
+		}
+
+		return true, tree("does NOT match:", explanation)
+	})
+}
+
+// AllOf matches the actual matches all of matchers, explanations of matchers mismatched are reported on failure.
+func AllOf(matchers ...assert.Matcher) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		var (
+			matched    []string
+			mismatched []string
+		)
+		for _, matcher := range matchers {
+			ok, explanation := matcher.Match(actual)
+			if ok {
+				matched = append(matched, explanation)
+			} else {
+				mismatched = append(mismatched, explanation)
+			}
+		}
+
+		if len(mismatched) > 0 {
+			return false, tree(fmt.Sprintf("%d of %d matcher(s) mismatched:", len(mismatched), len(matchers)), mismatched...)
+		}
+
+		return true, tree(fmt.Sprintf("all of %d matcher(s) matched:", len(matchers)), matched...)
+	})
+}
+
+// AnyOf matches the actual matches any of matchers, explanations of all matchers are reported on failure.
+func AnyOf(matchers ...assert.Matcher) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		var (
+			matched    []string
+			mismatched []string
+		)
+		for _, matcher := range matchers {
+			ok, explanation := matcher.Match(actual)
+			if ok {
+				matched = append(matched, explanation)
+			} else {
+				mismatched = append(mismatched, explanation)
+			}
+		}
+
+		if len(matched) == 0 {
+			return false, tree(fmt.Sprintf("none of %d matcher(s) matched:", len(matchers)), mismatched...)
+		}
+
+		return true, tree(fmt.Sprintf("%d of %d matcher(s) matched:", len(matched), len(matchers)), matched...)
+	})
+}
+
+// HasLen matches the actual of the length, which is a string, slice, array, map, channel or pointer to array.
+func HasLen(length int) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		n, ok := lengthOf(actual)
+		if !ok {
+			return false, fmt.Sprintf("%T has no length", actual)
+		}
+
+		if n != length {
+			return false, fmt.Sprintf("has length %d, expected %d", n, length)
+		}
+
+		return true, fmt.Sprintf("has length %d", n)
+	})
+}
+
+// HasKey matches the actual map has the key, the key is converted to the key type of the map if possible.
+func HasKey(key any) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		value := indirect(reflect.ValueOf(actual))
+		if value.Kind() != reflect.Map {
+			return false, fmt.Sprintf("%T is not a map", actual)
+		}
+
+		k, ok := convertTo(reflect.ValueOf(key), value.Type().Key())
+		if !ok {
+			return false, fmt.Sprintf("key %#v cannot be used as key of %T", key, actual)
+		}
+
+		if !value.MapIndex(k).IsValid() {
+			return false, fmt.Sprintf("key %#v is missing in %d key(s)", key, value.Len())
+		}
+
+		return true, fmt.Sprintf("has key %#v", key)
+	})
+}
+
+// HasField matches the field of the actual struct, or pointer to struct, matches the matcher.
+// The name can be dotted for nested fields, i.e. "Address.City". Unexported fields cannot be matched.
+func HasField(name string, matcher assert.Matcher) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		value := reflect.ValueOf(actual)
+		for _, field := range strings.Split(name, ".") {
+			value = indirect(value)
+			if value.Kind() != reflect.Struct {
+				return false, fmt.Sprintf("field %s of %T cannot be read from %s", field, actual, describeKind(value))
+			}
+
+			typ, ok := value.Type().FieldByName(field)
+			if !ok {
+				return false, fmt.Sprintf("%s has no field %s", value.Type(), field)
+			}
+			if !typ.IsExported() {
+				return false, fmt.Sprintf("field %s of %s is unexported", field, value.Type())
+			}
+
+			// NOTE: fields promoted through nil embedded pointers cannot be read
+			fieldValue, err := value.FieldByIndexErr(typ.Index)
+			if err != nil {
+				return false, fmt.Sprintf("field %s of %s cannot be read: %s", field, value.Type(), strings.TrimPrefix(err.Error(), "reflect: "))
+			}
+
+			value = fieldValue
+		}
+
+		ok, explanation := matcher.Match(value.Interface())
+
+		return ok, tree(fmt.Sprintf("field %s:", name), explanation)
+	})
+}
+
+// Each matches every element of the actual slice or array matches the matcher, an empty one matches.
+func Each(matcher assert.Matcher) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		elements, ok := elementsOf(actual)
+		if !ok {
+			return false, fmt.Sprintf("%T is not a slice or array", actual)
+		}
+
+		var mismatched []string
+		for i, element := range elements {
+			if ok, explanation := matcher.Match(element); !ok {
+				mismatched = append(mismatched, tree(fmt.Sprintf("#%d:", i), explanation))
+			}
+		}
+
+		if len(mismatched) > 0 {
+			return false, tree(fmt.Sprintf("%d of %d element(s) mismatched:", len(mismatched), len(elements)), mismatched...)
+		}
+
+		return true, fmt.Sprintf("all of %d element(s) matched", len(elements))
+	})
+}
+
+// ContainsElementMatching matches any element of the actual slice or array matches the matcher.
+func ContainsElementMatching(matcher assert.Matcher) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		elements, ok := elementsOf(actual)
+		if !ok {
+			return false, fmt.Sprintf("%T is not a slice or array", actual)
+		}
+
+		mismatched := make([]string, 0, len(elements))
+		for i, element := range elements {
+			ok, explanation := matcher.Match(element)
+			if ok {
+				return true, tree(fmt.Sprintf("element #%d matched:", i), explanation)
+			}
+
+			mismatched = append(mismatched, tree(fmt.Sprintf("#%d:", i), explanation))
+		}
+
+		if len(elements) == 0 {
+			return false, "has no elements"
+		}
+
+		return false, tree(fmt.Sprintf("none of %d element(s) matched:", len(elements)), mismatched...)
+	})
+}
+
+// StartsWith matches the actual string, or []byte, starts with the prefix.
+func StartsWith(prefix string) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		s, ok := stringOf(actual)
+		if !ok {
+			return false, fmt.Sprintf("%T is not a string", actual)
+		}
+
+		if !strings.HasPrefix(s, prefix) {
+			return false, fmt.Sprintf("%q does NOT start with %q", s, prefix)
+		}
+
+		return true, fmt.Sprintf("%q starts with %q", s, prefix)
+	})
+}
+
+// MatchesRegexp matches the actual matches the regexp, which is a string or *regexp.Regexp.
+// The actual other than string and []byte is formatted by fmt.Sprint, see assert.Match.
+func MatchesRegexp(reg any) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		var (
+			r   *regexp.Regexp
+			err error
+		)
+		switch v := reg.(type) {
+		case *regexp.Regexp:
+			r = v
+		case string:
+			r, err = regexp.Compile(v)
+		default:
+			err = fmt.Errorf("%T is not a string or *regexp.Regexp", reg)
+		}
+		if err != nil || r == nil {
+			return false, fmt.Sprintf("invalid regexp %v: %v", reg, err)
+		}
+
+		s, ok := stringOf(actual)
+		if !ok {
+			s = fmt.Sprint(actual)
+		}
+
+		if !r.MatchString(s) {
+			return false, fmt.Sprintf("%q does NOT match regexp %q", s, r.String())
+		}
+
+		return true, fmt.Sprintf("%q matches regexp %q", s, r.String())
+	})
+}
+
+// Between matches the actual between min and max inclusively, which are compared as assert.Greater.
+// Numbers of any types, including time.Duration, *big.Int and *big.Float, strings of any named types,
+// time.Time and *time.Time are supported, numbers are compared by value, i.e. Between(1, 10) matches
+// uint8(5) and 5.5.
+func Between(min, max any) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		lower, err := ordered.Compare(actual, min)
+		if err != nil {
+			return false, fmt.Sprintf("%T cannot be compared with %T", actual, min)
+		}
+
+		upper, err := ordered.Compare(actual, max)
+		if err != nil {
+			return false, fmt.Sprintf("%T cannot be compared with %T", actual, max)
+		}
+
+		if lower < 0 || upper > 0 {
+			return false, fmt.Sprintf("%v is NOT between %v and %v", actual, min, max)
+		}
+
+		return true, fmt.Sprintf("%v is between %v and %v", actual, min, max)
+	})
+}
+
+// IsJSONWith matches the value of the key in the actual JSON matches the matcher, which is a string,
+// []byte or json.RawMessage. The key is the same as assert.ContainsJSON, an empty key matches the JSON.
+// Values are decoded as by json.Unmarshal into any, i.e. numbers are float64, which Equals compares by value.
+//
+//	match.IsJSONWith("data.name", match.StartsWith("go"))
+func IsJSONWith(key string, matcher assert.Matcher) assert.Matcher {
+	return assert.MatcherFunc(func(actual any) (bool, string) {
+		s, ok := stringOf(actual)
+		if !ok {
+			return false, fmt.Sprintf("%T is not JSON of string or []byte", actual)
+		}
+
+		data, err := assert.JSONValue(s, key)
+		if err != nil {
+			return false, fmt.Sprintf("key %s cannot be found: %v", describeKey(key), err)
+		}
+
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			return false, fmt.Sprintf("key %s cannot be decoded: %v", describeKey(key), err)
+		}
+
+		ok, explanation := matcher.Match(value)
+
+		return ok, tree(fmt.Sprintf("key %s:", describeKey(key)), explanation)
+	})
+}
+
+// tree returns the header with explanations of children indented under it.
+func tree(header string, children ...string) string {
+	lines := []string{header}
+	for _, child := range children {
+		lines = append(lines, "  "+strings.ReplaceAll(child, "\n", "\n  "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func describeKey(key string) string {
+	if key == "" {
+		return "<root>"
+	}
+
+	return key
+}
+
+func describeKind(value reflect.Value) string {
+	if !value.IsValid() {
+		return "nil"
+	}
+
+	return value.Type().String()
+}
+
+// indirect dereferences pointers and interfaces of the value until nil or others.
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+
+		value = value.Elem()
+	}
+
+	return value
+}
+
+// convertTo returns the value converted to the type, it is false if not assignable or convertible.
+func convertTo(value reflect.Value, typ reflect.Type) (reflect.Value, bool) {
+	if !value.IsValid() {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			return reflect.Zero(typ), true
+		}
+
+		return value, false
+	}
+
+	if value.Type().AssignableTo(typ) {
+		return value, true
+	}
+
+	// NOTE: numbers are not converted into strings, i.e. string(rune(65))
+	if typ.Kind() == reflect.String && value.Kind() != reflect.String {
+		return value, false
+	}
+
+	if value.Type().ConvertibleTo(typ) {
+		return value.Convert(typ), true
+	}
+
+	return value, false
+}
+
+func lengthOf(v any) (int, bool) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr && value.Type().Elem().Kind() == reflect.Array {
+		return value.Type().Elem().Len(), true
+	}
+
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return value.Len(), true
+	}
+
+	return 0, false
+}
+
+func elementsOf(v any) ([]any, bool) {
+	value := reflect.ValueOf(v)
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		elements := make([]any, value.Len())
+		for i := range elements {
+			elements[i] = value.Index(i).Interface()
+		}
+
+		return elements, true
+	}
+
+	return nil, false
+}
+
+func stringOf(v any) (string, bool) {
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case []byte:
+		return string(s), true
+	case json.RawMessage:
+		return string(s), true
+	}
+
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.String {
+		return value.String(), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,139 @@
+package match
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golib/assert"
+	"github.com/golib/assert/asserttest"
+)
+
+type address struct {
+	City string
+}
+
+type member struct {
+	*address
+
+	Role string
+}
+
+type level string
+
+type user struct {
+	Name    string
+	Age     int
+	Tags    []string
+	Address *address
+	secret  string
+}
+
+func TestMatchers(t *testing.T) {
+	now := time.Now()
+	u := &user{
+		Name:    "golib",
+		Age:     18,
+		Tags:    []string{"go", "assert"},
+		Address: &address{City: "Beijing"},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		actual      any
+		matcher     assert.Matcher
+		ok          bool
+		explanation string
+	}{
+		{"equals", "golib", Equals("golib"), true, `"golib" is equal to "golib"`},
+		{"equals by value", int64(7), Equals(7), true, `7 is equal to 7`},
+		{"not equals", "golib", Equals("gopher"), false, `"golib" is NOT equal to "gopher"`},
+		{"not", "golib", Not(Equals("gopher")), true, "does NOT match:\n  \"golib\" is NOT equal to \"gopher\""},
+		{"not mismatched", "golib", Not(Equals("golib")), false, "expected NOT to match, but it does:\n  \"golib\" is equal to \"golib\""},
+		{"has len", []int{1, 2}, HasLen(2), true, "has length 2"},
+		{"has len mismatched", "golib", HasLen(2), false, "has length 5, expected 2"},
+		{"has len of int", 1, HasLen(1), false, "int has no length"},
+		{"has key", map[string]int{"a": 1}, HasKey("a"), true, `has key "a"`},
+		{"has key converted", map[int64]int{1: 1}, HasKey(1), true, `has key 1`},
+		{"has key missing", map[string]int{"a": 1}, HasKey("b"), false, `key "b" is missing in 1 key(s)`},
+		{"has key of slice", []int{}, HasKey(1), false, "[]int is not a map"},
+		{"has field", u, HasField("Address.City", Equals("Beijing")), true, "field Address.City:\n  \"Beijing\" is equal to \"Beijing\""},
+		{"has field missing", u, HasField("Email", Equals("")), false, "match.user has no field Email"},
+		{"has field unexported", u, HasField("secret", Equals("")), false, "field secret of match.user is unexported"},
+		{"has field of nil", &user{}, HasField("Address.City", Equals("")), false, "field City of *match.user cannot be read from nil"},
+		{"has field promoted", member{address: &address{City: "Beijing"}}, HasField("City", Equals("Beijing")), true, "field City:\n  \"Beijing\" is equal to \"Beijing\""},
+		{"has field of nil embedded", member{Role: "admin"}, HasField("City", Equals("")), false, "field City of match.member cannot be read: indirection through nil pointer to embedded struct field address"},
+		{"each", u.Tags, Each(MatchesRegexp(`^[a-z]+$`)), true, "all of 2 element(s) matched"},
+		{"each mismatched", []int{1, 5, 10}, Each(Between(1, 5)), false, "1 of 3 element(s) mismatched:\n  #2:\n    10 is NOT between 1 and 5"},
+		{"contains element", u.Tags, ContainsElementMatching(StartsWith("ass")), true, "element #1 matched:\n  \"assert\" starts with \"ass\""},
+		{"contains element mismatched", []string{"go"}, ContainsElementMatching(StartsWith("ass")), false, "none of 1 element(s) matched:\n  #0:\n    \"go\" does NOT start with \"ass\""},
+		{"contains element of empty", []string{}, ContainsElementMatching(StartsWith("ass")), false, "has no elements"},
+		{"starts with bytes", []byte("golib"), StartsWith("go"), true, `"golib" starts with "go"`},
+		{"matches regexp", 123, MatchesRegexp(regexp.MustCompile(`^\d+$`)), true, `"123" matches regexp "^\\d+$"`},
+		{"invalid regexp", "golib", MatchesRegexp(`(`), false, "invalid regexp (: error parsing regexp: missing closing ): `(`"},
+		{"between", uint8(5), Between(1, 10.5), true, "5 is between 1 and 10.5"},
+		{"between strings", "b", Between("a", "c"), true, "b is between a and c"},
+		{"between durations", time.Minute, Between(time.Second, time.Hour), true, "1m0s is between 1s and 1h0m0s"},
+		{"between mismatched", -1, Between(uint(0), uint(10)), false, "-1 is NOT between 0 and 10"},
+		{"between incomparable", "a", Between(1, 2), false, "string cannot be compared with int"},
+		{"between big numbers", big.NewInt(5), Between(big.NewFloat(0.5), uint64(10)), true, "5 is between 0.5 and 10"},
+		{"between time pointers", &now, Between(now.Add(-time.Second), &now), true, fmt.Sprintf("%v is between %v and %v", &now, now.Add(-time.Second), &now)},
+		{"between named strings", level("info"), Between(level("debug"), level("warn")), true, "info is between debug and warn"},
+		{"between NaN", math.NaN(), Between(0, 1), false, "float64 cannot be compared with int"},
+		{"is json with", `{"data": {"id": 7}}`, IsJSONWith("data.id", Equals(7)), true, "key data.id:\n  7 is equal to 7"},
+		{"is json with missing", `{"data": {}}`, IsJSONWith("data.id", Equals(7)), false, "key data.id cannot be found: Key path not found"},
+		{"is json with root", []byte(`[1, 2]`), IsJSONWith("", HasLen(2)), true, "key <root>:\n  has length 2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, explanation := tc.matcher.Match(tc.actual)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.explanation, explanation)
+		})
+	}
+}
+
+func TestAllOf(t *testing.T) {
+	matcher := AllOf(
+		HasField("Name", StartsWith("go")),
+		HasField("Age", Between(18, 60)),
+	)
+
+	ok, explanation := matcher.Match(user{Name: "golib", Age: 18})
+	assert.True(t, ok)
+	assert.Equal(t, "all of 2 matcher(s) matched:\n  field Name:\n    \"golib\" starts with \"go\"\n  field Age:\n    18 is between 18 and 60", explanation)
+
+	ok, explanation = matcher.Match(user{Name: "golib", Age: 17})
+	assert.False(t, ok)
+	assert.Equal(t, "1 of 2 matcher(s) mismatched:\n  field Age:\n    17 is NOT between 18 and 60", explanation)
+}
+
+func TestAnyOf(t *testing.T) {
+	matcher := AnyOf(Equals("go"), StartsWith("lib"))
+
+	ok, explanation := matcher.Match("golib")
+	assert.False(t, ok)
+	assert.Equal(t, "none of 2 matcher(s) matched:\n  \"golib\" is NOT equal to \"go\"\n  \"golib\" does NOT start with \"lib\"", explanation)
+
+	ok, explanation = matcher.Match("go")
+	assert.True(t, ok)
+	assert.Equal(t, "1 of 2 matcher(s) matched:\n  \"go\" is equal to \"go\"", explanation)
+}
+
+func TestThat(t *testing.T) {
+	assert.True(t, assert.That(t, []string{"go", "assert"}, AllOf(HasLen(2), Each(MatchesRegexp(`^[a-z]+$`)))))
+
+	rec := asserttest.NewRecorder(t)
+	assert.False(t, assert.New(rec).That(
+		`{"data": {"users": [{"name": "golib"}, {"nick": "GO"}]}}`,
+		IsJSONWith("data.users", Each(HasKey("name"))),
+		"user %s", "golib",
+	))
+
+	rec.AssertFailedWith("Error", "Expected value to match, but it does NOT:\nkey data.users:\n  1 of 2 element(s) mismatched:\n    #1:\n      key \"name\" is missing in 1 key(s)")
+	rec.AssertFailedWith("Actual", `{"data": {"users"`)
+	rec.AssertFailedWith("Messages", "user golib")
+}
@@ -0,0 +1,77 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kr/pretty"
+)
+
+// Matcher matches the actual value for That. The explanation tells why the actual value matches or not,
+// explanations of nested matchers are indented by two spaces under the explanation of their parent,
+// which renders as a tree in failures.
+//
+// See package github.com/golib/assert/match for matchers and combinators.
+type Matcher interface {
+	Match(actual any) (ok bool, explanation string)
+}
+
+// MatcherFunc is an adapter to use the func as Matcher.
+type MatcherFunc func(actual any) (ok bool, explanation string)
+
+// Match calls fn(actual).
+func (fn MatcherFunc) Match(actual any) (ok bool, explanation string) {
+	return fn(actual)
+}
+
+// That asserts that the actual matches the matcher, the explanation of the matcher is reported on failure.
+//
+//	assert.That(t, user, match.AllOf(
+//	  match.HasField("Name", match.StartsWith("go")),
+//	  match.HasField("Tags", match.Each(match.MatchesRegexp(`^[a-z]+$`))),
+//	))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func That(t Testing, actual any, matcher Matcher, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if matcher == nil {
+		return Fail(t, "Expected a Matcher, but got nil", formatAndArgs...)
+	}
+
+	ok, explanation := matcher.Match(actual)
+	if !ok {
+		return failWithContent(t,
+			"Expected value to match, but it does NOT:\n"+explanation,
+			[]labeledContent{
+				{"Actual", pretty.Sprintf("%# v", actual)},
+			},
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// JSONValue returns the raw JSON value of the key, which is the same as the key of ContainsJSON,
+// i.e. dotted keys with subscripts, JSON pointer or JSONPath query matching exactly one value.
+// An empty key returns the JSON as is.
+//
+// NOTE: This func does no assertion of any kind.
+func JSONValue(jsonStr, key string) (json.RawMessage, error) {
+	if key == "" {
+		if !json.Valid([]byte(jsonStr)) {
+			return nil, fmt.Errorf("invalid JSON %q", jsonStr)
+		}
+
+		return json.RawMessage(jsonStr), nil
+	}
+
+	data, err := getJsonRawValue(jsonStr, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(data), nil
+}
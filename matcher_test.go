@@ -0,0 +1,49 @@
+package assert
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_That(t *testing.T) {
+	isEven := MatcherFunc(func(actual any) (bool, string) {
+		n, ok := actual.(int)
+		if !ok || n%2 != 0 {
+			return false, fmt.Sprintf("is NOT even:\n  %v", actual)
+		}
+
+		return true, "is even"
+	})
+
+	True(t, That(t, 2, isEven))
+
+	mockT := &mockTBTesting{}
+	False(t, That(mockT, 3, isEven, "hello, %s!", "world"))
+	Contains(t, mockT.buf.String(), "\tError:   \tExpected value to match, but it does NOT:\n\t\t\t         \tis NOT even:\n\t\t\t         \t  3\n")
+	Contains(t, mockT.buf.String(), "\tActual:  \tint(3)\n")
+	Contains(t, mockT.buf.String(), "\tMessages:\thello, world!\n")
+
+	mockT = &mockTBTesting{}
+	False(t, That(mockT, 2, nil))
+	Contains(t, mockT.buf.String(), "Expected a Matcher, but got nil")
+}
+
+func Test_JSONValue(t *testing.T) {
+	data, err := JSONValue(`{"data": {"name": "golib", "ids": [1, 2]}}`, "data.name")
+	Nil(t, err)
+	Equal(t, `"golib"`, string(data))
+
+	data, err = JSONValue(`{"data": {"name": "golib", "ids": [1, 2]}}`, "/data/ids")
+	Nil(t, err)
+	Equal(t, `[1,2]`, string(data))
+
+	data, err = JSONValue(`[1, 2]`, "")
+	Nil(t, err)
+	Equal(t, `[1, 2]`, string(data))
+
+	_, err = JSONValue(`{"data"`, "")
+	NotNil(t, err)
+
+	_, err = JSONValue(`{"data": {}}`, "data.name")
+	NotNil(t, err)
+}
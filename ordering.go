@@ -0,0 +1,260 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/golib/assert/internal/ordered"
+)
+
+// formatOrdered returns the value for failures, strings are quoted.
+func formatOrdered(v any) string {
+	if reflect.ValueOf(v).Kind() == reflect.String {
+		return fmt.Sprintf("%q", v)
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+// assertOrdered asserts that ok(ordered.Compare(v, than)) is true, relation is used by the failure.
+func assertOrdered(t Testing, v, than any, relation string, ok func(n int) bool, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	n, err := ordered.Compare(v, than)
+	if err != nil {
+		return Fail(t, "Expected ordered values, but "+err.Error(), formatAndArgs...)
+	}
+
+	if !ok(n) {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be %s %s", formatOrdered(v), relation, formatOrdered(than)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// Greater asserts that the v is greater than the than. Numbers of any types, including time.Duration,
+// *big.Int and *big.Float, are compared by value, strings are compared lexically and time.Time are
+// compared by instant.
+//
+//	assert.Greater(t, len(users), 0)
+//	assert.Greater(t, time.Now(), createdAt)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Greater(t Testing, v, than any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrdered(t, v, than, "greater than", func(n int) bool { return n > 0 }, formatAndArgs...)
+}
+
+// GreaterOrEqual asserts that the v is greater than or equal to the than. See Greater for values supported.
+//
+//	assert.GreaterOrEqual(t, user.Age, 18)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GreaterOrEqual(t Testing, v, than any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrdered(t, v, than, "greater than or equal to", func(n int) bool { return n >= 0 }, formatAndArgs...)
+}
+
+// Less asserts that the v is less than the than. See Greater for values supported.
+//
+//	assert.Less(t, elapsed, time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Less(t Testing, v, than any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrdered(t, v, than, "less than", func(n int) bool { return n < 0 }, formatAndArgs...)
+}
+
+// LessOrEqual asserts that the v is less than or equal to the than. See Greater for values supported.
+//
+//	assert.LessOrEqual(t, len(page), 20)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LessOrEqual(t Testing, v, than any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertOrdered(t, v, than, "less than or equal to", func(n int) bool { return n <= 0 }, formatAndArgs...)
+}
+
+// Between asserts that the v is between lo and hi inclusively. See Greater for values supported.
+//
+//	assert.Between(t, user.Age, 18, 60)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Between(t Testing, v, lo, hi any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	lower, err := ordered.Compare(v, lo)
+	if err != nil {
+		return Fail(t, "Expected ordered values, but "+err.Error(), formatAndArgs...)
+	}
+
+	upper, err := ordered.Compare(v, hi)
+	if err != nil {
+		return Fail(t, "Expected ordered values, but "+err.Error(), formatAndArgs...)
+	}
+
+	if lower < 0 || upper > 0 {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be between %s and %s", formatOrdered(v), formatOrdered(lo), formatOrdered(hi)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// Positive asserts that the number is greater than zero. See Greater for numbers supported.
+//
+//	assert.Positive(t, balance)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Positive(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	n, ok := ordered.Number(v)
+	if !ok {
+		return Fail(t, fmt.Sprintf("Expected a number, but got %T(%v)", v, v), formatAndArgs...)
+	}
+
+	if n.Sign() <= 0 {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be positive", formatOrdered(v)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// Negative asserts that the number is less than zero. See Greater for numbers supported.
+//
+//	assert.Negative(t, offset)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Negative(t Testing, v any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	n, ok := ordered.Number(v)
+	if !ok {
+		return Fail(t, fmt.Sprintf("Expected a number, but got %T(%v)", v, v), formatAndArgs...)
+	}
+
+	if n.Sign() >= 0 {
+		return Fail(t,
+			fmt.Sprintf("Expected %s to be negative", formatOrdered(v)),
+			formatAndArgs...)
+	}
+
+	return true
+}
+
+// assertMonotonic asserts that every element of the list is ok(ordered.Compare(element, previous)),
+// the failure points at the first element out of order.
+func assertMonotonic(t Testing, list any, order, relation string, ok func(n int) bool, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	// NOTE: keys of map are not ordered
+	if reflect.ValueOf(list).Kind() == reflect.Map {
+		return Fail(t, fmt.Sprintf("%T is not a slice, array or iter.Seq", list), formatAndArgs...)
+	}
+
+	elements, isCollection := collectionElements(list)
+	if !isCollection {
+		return Fail(t, fmt.Sprintf("%T is not a slice, array or iter.Seq", list), formatAndArgs...)
+	}
+
+	for i := 1; i < len(elements); i++ {
+		n, err := ordered.Compare(elements[i], elements[i-1])
+		if err != nil {
+			return Fail(t,
+				fmt.Sprintf("Element #%d cannot be compared with element #%d: %v", i, i-1, err),
+				formatAndArgs...)
+		}
+
+		if !ok(n) {
+			return Fail(t,
+				fmt.Sprintf("Expected elements to be %s, but element #%d %s is NOT %s element #%d %s",
+					order, i, formatOrdered(elements[i]), relation, i-1, formatOrdered(elements[i-1])),
+				formatAndArgs...)
+		}
+	}
+
+	return true
+}
+
+// IsIncreasing asserts that elements of the list are strictly increasing. The list is a slice, array
+// or iter.Seq, see Greater for elements supported.
+//
+//	assert.IsIncreasing(t, []int{1, 2, 3})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsIncreasing(t Testing, list any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertMonotonic(t, list, "strictly increasing", "greater than", func(n int) bool { return n > 0 }, formatAndArgs...)
+}
+
+// IsDecreasing asserts that elements of the list are strictly decreasing. See IsIncreasing for lists supported.
+//
+//	assert.IsDecreasing(t, []int{3, 2, 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsDecreasing(t Testing, list any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertMonotonic(t, list, "strictly decreasing", "less than", func(n int) bool { return n < 0 }, formatAndArgs...)
+}
+
+// IsNonDecreasing asserts that every element of the list is greater than or equal to the previous one.
+// See IsIncreasing for lists supported.
+//
+//	assert.IsNonDecreasing(t, []int{1, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNonDecreasing(t Testing, list any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertMonotonic(t, list, "non-decreasing", "greater than or equal to", func(n int) bool { return n >= 0 }, formatAndArgs...)
+}
+
+// IsNonIncreasing asserts that every element of the list is less than or equal to the previous one.
+// See IsIncreasing for lists supported.
+//
+//	assert.IsNonIncreasing(t, []int{2, 1, 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNonIncreasing(t Testing, list any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return assertMonotonic(t, list, "non-increasing", "less than or equal to", func(n int) bool { return n <= 0 }, formatAndArgs...)
+}
@@ -0,0 +1,246 @@
+package assert
+
+import (
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Greater(t *testing.T) {
+	mockT := new(testing.T)
+	now := time.Now()
+
+	True(t, Greater(mockT, 2, 1))
+	True(t, Greater(mockT, int8(2), uint64(1)))
+	True(t, Greater(mockT, uint(math.MaxUint), int64(math.MaxInt64)))
+	True(t, Greater(mockT, uintptr(2), uintptr(1)))
+	True(t, Greater(mockT, 1.5, 1))
+	True(t, Greater(mockT, "b", "a"))
+	True(t, Greater(mockT, time.Hour, time.Minute))
+	True(t, Greater(mockT, now.Add(time.Second), now))
+	True(t, Greater(mockT, &now, now.Add(-time.Second)))
+	True(t, Greater(mockT, new(big.Int).Lsh(big.NewInt(1), 100), uint64(math.MaxUint64)))
+	True(t, Greater(mockT, big.NewFloat(0.5), big.NewInt(0)))
+
+	False(t, Greater(mockT, 1, 1))
+	False(t, Greater(mockT, "a", "b"))
+	False(t, Greater(mockT, uint64(math.MaxUint64), new(big.Int).Lsh(big.NewInt(1), 64)))
+
+	mockTB := &mockTBTesting{}
+	False(t, Greater(mockTB, "a", "b"))
+	Contains(t, mockTB.buf.String(), `Expected "a" to be greater than "b"`)
+
+	mockTB = &mockTBTesting{}
+	False(t, Greater(mockTB, "1", 0))
+	Contains(t, mockTB.buf.String(), "Expected ordered values, but cannot compare string with int")
+
+	mockTB = &mockTBTesting{}
+	False(t, Greater(mockTB, now, 0))
+	Contains(t, mockTB.buf.String(), "Expected ordered values, but cannot compare time.Time with int")
+
+	mockTB = &mockTBTesting{}
+	False(t, Greater(mockTB, math.NaN(), 0))
+	Contains(t, mockTB.buf.String(), "Expected ordered values, but cannot compare NaN")
+
+	mockTB = &mockTBTesting{}
+	False(t, Greater(mockTB, []int{1}, 0))
+	Contains(t, mockTB.buf.String(), "Expected ordered values, but cannot compare []int with int")
+}
+
+func Test_GreaterOrEqualLess(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, GreaterOrEqual(mockT, 1, 1.0))
+	True(t, GreaterOrEqual(mockT, "b", "a"))
+	False(t, GreaterOrEqual(mockT, time.Second, time.Minute))
+
+	True(t, Less(mockT, -1, uint(0)))
+	True(t, Less(mockT, time.Second, time.Minute))
+	False(t, Less(mockT, 1, 1))
+
+	True(t, LessOrEqual(mockT, 1, 1))
+	True(t, LessOrEqual(mockT, big.NewInt(1), big.NewFloat(1)))
+	False(t, LessOrEqual(mockT, 2, 1))
+
+	mockTB := &mockTBTesting{}
+	False(t, LessOrEqual(mockTB, time.Minute, time.Second))
+	Contains(t, mockTB.buf.String(), "Expected 1m0s to be less than or equal to 1s")
+}
+
+func Test_Between(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, Between(mockT, 5, 1, 10))
+	True(t, Between(mockT, 1, 1, 10))
+	True(t, Between(mockT, uint8(10), 1, 10.0))
+	True(t, Between(mockT, "b", "a", "c"))
+	True(t, Between(mockT, time.Minute, time.Second, time.Hour))
+
+	False(t, Between(mockT, 0, 1, 10))
+	False(t, Between(mockT, 11, 1, 10))
+
+	mockTB := &mockTBTesting{}
+	False(t, Between(mockTB, -1, uint(0), uint(10)))
+	Contains(t, mockTB.buf.String(), "Expected -1 to be between 0 and 10")
+
+	mockTB = &mockTBTesting{}
+	False(t, Between(mockTB, 1, 0, "10"))
+	Contains(t, mockTB.buf.String(), "Expected ordered values, but cannot compare int with string")
+}
+
+func Test_PositiveNegative(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, Positive(mockT, 1))
+	True(t, Positive(mockT, uint(1)))
+	True(t, Positive(mockT, 0.1))
+	True(t, Positive(mockT, time.Second))
+	True(t, Positive(mockT, big.NewInt(1)))
+	False(t, Positive(mockT, 0))
+	False(t, Positive(mockT, -time.Second))
+
+	True(t, Negative(mockT, -1))
+	True(t, Negative(mockT, big.NewFloat(-0.1)))
+	False(t, Negative(mockT, 0))
+	False(t, Negative(mockT, uint(1)))
+
+	mockTB := &mockTBTesting{}
+	False(t, Positive(mockTB, -1))
+	Contains(t, mockTB.buf.String(), "Expected -1 to be positive")
+
+	mockTB = &mockTBTesting{}
+	False(t, Negative(mockTB, "-1"))
+	Contains(t, mockTB.buf.String(), "Expected a number, but got string(-1)")
+
+	mockTB = &mockTBTesting{}
+	False(t, Negative(mockTB, math.NaN()))
+	Contains(t, mockTB.buf.String(), "Expected a number, but got float64(NaN)")
+}
+
+func Test_IsIncreasing(t *testing.T) {
+	mockT := new(testing.T)
+	now := time.Now()
+
+	True(t, IsIncreasing(mockT, []int{1, 2, 3}))
+	True(t, IsIncreasing(mockT, [3]string{"a", "b", "c"}))
+	True(t, IsIncreasing(mockT, []any{1, uint(2), 2.5, big.NewInt(3)}))
+	True(t, IsIncreasing(mockT, []time.Time{now, now.Add(time.Second)}))
+	True(t, IsIncreasing(mockT, slices.Values([]int{1, 2})))
+	True(t, IsIncreasing(mockT, []int{}))
+	True(t, IsIncreasing(mockT, nil))
+
+	False(t, IsIncreasing(mockT, []int{1, 1, 2}))
+	False(t, IsIncreasing(mockT, map[int]bool{1: true}))
+
+	mockTB := &mockTBTesting{}
+	False(t, IsIncreasing(mockTB, []int{1, 2, 5, 4, 3}))
+	Contains(t, mockTB.buf.String(), "Expected elements to be strictly increasing, but element #3 4 is NOT greater than element #2 5")
+
+	mockTB = &mockTBTesting{}
+	False(t, IsIncreasing(mockTB, []any{1, "2"}))
+	Contains(t, mockTB.buf.String(), "Element #1 cannot be compared with element #0: cannot compare string with int")
+
+	mockTB = &mockTBTesting{}
+	False(t, IsIncreasing(mockTB, 1))
+	Contains(t, mockTB.buf.String(), "int is not a slice, array or iter.Seq")
+}
+
+func Test_IsMonotonic(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, IsDecreasing(mockT, []int{3, 2, 1}))
+	False(t, IsDecreasing(mockT, []int{3, 3, 1}))
+
+	True(t, IsNonDecreasing(mockT, []int{1, 1, 2}))
+	False(t, IsNonDecreasing(mockT, []int{1, 2, 1}))
+
+	True(t, IsNonIncreasing(mockT, []time.Duration{time.Hour, time.Hour, time.Second}))
+	False(t, IsNonIncreasing(mockT, []int{2, 1, 2}))
+
+	mockTB := &mockTBTesting{}
+	False(t, IsDecreasing(mockTB, []string{"c", "b", "b"}))
+	Contains(t, mockTB.buf.String(), `Expected elements to be strictly decreasing, but element #2 "b" is NOT less than element #1 "b"`)
+
+	mockTB = &mockTBTesting{}
+	False(t, IsNonDecreasing(mockTB, []float64{1, 0.5}))
+	Contains(t, mockTB.buf.String(), "Expected elements to be non-decreasing, but element #1 0.5 is NOT greater than or equal to element #0 1")
+}
+
+func Test_OrderedOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, GreaterOf(mockT, 2, 1))
+	True(t, GreaterOf(mockT, uintptr(2), 1))
+	False(t, GreaterOf(mockT, "a", "b"))
+
+	True(t, GreaterOrEqualOf(mockT, time.Second, time.Second))
+	False(t, GreaterOrEqualOf(mockT, 1.5, 2))
+
+	True(t, LessOf(mockT, uint64(1), math.MaxUint64))
+	False(t, LessOf(mockT, 1, 1))
+
+	True(t, LessOrEqualOf(mockT, "a", "a"))
+	False(t, LessOrEqualOf(mockT, int8(2), 1))
+
+	True(t, BetweenOf(mockT, 5, 1, 10))
+	False(t, BetweenOf(mockT, 0.5, 1, 10))
+
+	True(t, PositiveOf(mockT, time.Second))
+	False(t, PositiveOf(mockT, uint(0)))
+
+	True(t, NegativeOf(mockT, -0.5))
+	False(t, NegativeOf(mockT, 0))
+
+	// NOTE: NaN is neither positive nor negative
+	False(t, PositiveOf(mockT, math.NaN()))
+	False(t, NegativeOf(mockT, math.NaN()))
+
+	mockTB := &mockTBTesting{}
+	False(t, LessOf(mockTB, "b", "a"))
+	Contains(t, mockTB.buf.String(), `Expected "b" to be less than "a"`)
+
+	mockTB = &mockTBTesting{}
+	False(t, BetweenOf(mockTB, time.Hour, time.Second, time.Minute))
+	Contains(t, mockTB.buf.String(), "Expected 1h0m0s to be between 1s and 1m0s")
+}
+
+func Test_IsSorted(t *testing.T) {
+	mockT := new(testing.T)
+
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+
+	True(t, IsSorted(mockT, []string{"go", "lib", "test"}, byLength))
+	True(t, IsSorted(mockT, []string{"go", "to", "lib"}, byLength))
+	True(t, IsSorted(mockT, []string(nil), byLength))
+	False(t, IsSorted(mockT, []string{"lib", "go"}, byLength))
+
+	mockTB := &mockTBTesting{}
+	False(t, IsSorted(mockTB, []string{"go", "lib", "test", "a"}, byLength))
+	Contains(t, mockTB.buf.String(), `Expected elements to be sorted, but element #3 "a" is less than element #2 "test"`)
+
+	mockTB = &mockTBTesting{}
+	False(t, IsSorted(mockTB, []string{"a", "B"}, func(a, b string) bool { return strings.ToLower(a) > strings.ToLower(b) }))
+	Contains(t, mockTB.buf.String(), `element #1 "B" is less than element #0 "a"`)
+}
+
+func TestOrderingWrappers(t *testing.T) {
+	it := New(new(testing.T))
+
+	True(t, it.Greater(2, 1))
+	True(t, it.GreaterOrEqual(1, 1))
+	True(t, it.Less(time.Second, time.Minute))
+	True(t, it.LessOrEqual("a", "a"))
+	True(t, it.Between(5, 1, 10))
+	True(t, it.Positive(1))
+	True(t, it.Negative(-1))
+	True(t, it.IsIncreasing([]int{1, 2}))
+	True(t, it.IsDecreasing([]int{2, 1}))
+	True(t, it.IsNonDecreasing([]int{1, 1}))
+	True(t, it.IsNonIncreasing([]int{1, 1}))
+
+	False(t, it.Between(0, 1, 10))
+	False(t, it.IsIncreasing([]int{2, 1}))
+}
@@ -0,0 +1,296 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/kr/pretty"
+)
+
+// ReaderLimit is the max size of data read by reader assertions, it is unlimited if not positive.
+// Use WithReaderLimit to change it for *Assertions.
+var ReaderLimit int64 = 64 << 20
+
+// errReaderLimit is returned when the reader has more data than the limit.
+type errReaderLimit struct {
+	limit int64
+}
+
+func (e *errReaderLimit) Error() string {
+	return fmt.Sprintf("data exceeds limit of %d bytes", e.limit)
+}
+
+// bufferedReadCloser reads buffered data followed by the rest of the original, and closes the original.
+type bufferedReadCloser struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (r *bufferedReadCloser) Close() error {
+	return r.closer.Close()
+}
+
+// readReader reads data of the reader for assertions, and keeps the reader readable from where it was:
+//
+//   - *bytes.Buffer is read without consuming it;
+//   - *io.ReadCloser and *io.Reader, i.e. &resp.Body, are swapped with a reader of the buffered data;
+//   - io.Seeker, i.e. *os.File and *strings.Reader, are seeked back to the offset;
+//   - other io.Reader are consumed.
+func readReader(reader any, limit int64) ([]byte, error) {
+	switch r := reader.(type) {
+	case *bytes.Buffer:
+		if limit > 0 && int64(r.Len()) > limit {
+			return nil, &errReaderLimit{limit: limit}
+		}
+
+		return r.Bytes(), nil
+
+	case *io.ReadCloser:
+		if r == nil || *r == nil {
+			return nil, errors.New("nil *io.ReadCloser")
+		}
+
+		original := *r
+
+		data, err := readLimited(original, limit)
+		*r = &bufferedReadCloser{
+			Reader: io.MultiReader(bytes.NewReader(data), original),
+			closer: original,
+		}
+
+		return data, err
+
+	case *io.Reader:
+		if r == nil || *r == nil {
+			return nil, errors.New("nil *io.Reader")
+		}
+
+		original := *r
+
+		data, err := readLimited(original, limit)
+		*r = io.MultiReader(bytes.NewReader(data), original)
+
+		return data, err
+
+	case io.ReadSeeker:
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			// NOTE: pipes and terminals cannot seek, they are consumed
+			return readLimited(r, limit)
+		}
+
+		data, err := readLimited(r, limit)
+		if _, seekErr := r.Seek(offset, io.SeekStart); seekErr != nil && err == nil {
+			err = seekErr
+		}
+
+		return data, err
+
+	case io.Reader:
+		return readLimited(r, limit)
+	}
+
+	return nil, fmt.Errorf("%T is not an io.Reader", reader)
+}
+
+// readLimited reads all data of the reader up to the limit, data read is returned along with errors.
+func readLimited(reader io.Reader, limit int64) ([]byte, error) {
+	if limit <= 0 {
+		return io.ReadAll(reader)
+	}
+
+	// NOTE: read one more byte to detect overflow, the buffered data should be complete for swapping
+	data, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err == nil && int64(len(data)) > limit {
+		err = &errReaderLimit{limit: limit}
+	}
+
+	return data, err
+}
+
+// readerData returns data of the reader, and reports the failure if it cannot be read.
+func readerData(t Testing, reader any, limit int64, formatAndArgs ...any) ([]byte, bool) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, err := readReader(reader, limit)
+	if err != nil {
+		return nil, Fail(t,
+			pretty.Sprintf("Failed to read from \"%T\": %s", reader, err.Error()),
+			formatAndArgs...)
+	}
+
+	return data, true
+}
+
+// ReaderContains asserts that the reader contains the specified sub string or element.
+// The reader is kept readable from where it was if it is *bytes.Buffer, io.Seeker, *io.Reader or *io.ReadCloser,
+// other readers are consumed. Data beyond ReaderLimit fails the assertion.
+//
+//	assert.ReaderContains(t, &resp.Body, "Earth", "But 'http.Response.Body' does NOT contain 'Earth'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ReaderContains(t Testing, reader, contains any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerContains(t, reader, contains, ReaderLimit, formatAndArgs...)
+}
+
+// ReaderNotContains asserts that the reader does not contain the specified substring or element.
+// See ReaderContains for readers supported.
+//
+//	assert.ReaderNotContains(t, &resp.Body, "Earth", "But 'http.Response.Body' does contain 'Earth'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ReaderNotContains(t Testing, reader, contains any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerNotContains(t, reader, contains, ReaderLimit, formatAndArgs...)
+}
+
+// ReaderEqual asserts that data of the reader is equal to the expected string.
+// See ReaderContains for readers supported.
+//
+//	assert.ReaderEqual(t, &resp.Body, "Hello, World")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ReaderEqual(t Testing, reader any, expected string, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerEqual(t, reader, expected, ReaderLimit, formatAndArgs...)
+}
+
+// ReaderMatch asserts that data of the reader matches the regexp, which is a string or *regexp.Regexp.
+// See ReaderContains for readers supported.
+//
+//	assert.ReaderMatch(t, &resp.Body, `^Hello, \w+$`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ReaderMatch(t Testing, reader, reg any, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerMatch(t, reader, reg, ReaderLimit, formatAndArgs...)
+}
+
+// ReaderJSON asserts that data of the reader is JSON equivalent to the expected, see EqualJSON.
+// See ReaderContains for readers supported.
+//
+//	assert.ReaderJSON(t, &resp.Body, `{"hello": "world"}`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ReaderJSON(t Testing, reader any, expected string, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerJSON(t, reader, expected, ReaderLimit, formatAndArgs...)
+}
+
+// ReaderLen asserts that the reader has length bytes of data.
+// See ReaderContains for readers supported.
+//
+//	assert.ReaderLen(t, &resp.Body, 12)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ReaderLen(t Testing, reader any, length int, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return readerLen(t, reader, length, ReaderLimit, formatAndArgs...)
+}
+
+func readerContains(t Testing, reader, contains any, limit int64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, ok := readerData(t, reader, limit, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	return Contains(t, string(data), contains, formatAndArgs...)
+}
+
+func readerNotContains(t Testing, reader, contains any, limit int64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, ok := readerData(t, reader, limit, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	return NotContains(t, string(data), contains, formatAndArgs...)
+}
+
+func readerEqual(t Testing, reader any, expected string, limit int64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, ok := readerData(t, reader, limit, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	return Equal(t, expected, string(data), formatAndArgs...)
+}
+
+func readerMatch(t Testing, reader, reg any, limit int64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, ok := readerData(t, reader, limit, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	return Match(t, reg, string(data), formatAndArgs...)
+}
+
+func readerJSON(t Testing, reader any, expected string, limit int64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, ok := readerData(t, reader, limit, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	return EqualJSON(t, expected, string(data), formatAndArgs...)
+}
+
+func readerLen(t Testing, reader any, length int, limit int64, formatAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, ok := readerData(t, reader, limit, formatAndArgs...)
+	if !ok {
+		return false
+	}
+
+	if len(data) != length {
+		return Fail(t,
+			fmt.Sprintf("Expected reader of %d byte(s), but got %d", length, len(data)),
+			formatAndArgs...)
+	}
+
+	return true
+}
@@ -0,0 +1,133 @@
+package assert
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReaderContains(t *testing.T) {
+	mockT := new(testing.T)
+
+	// for seeker
+	reader := strings.NewReader("Hello, World")
+	True(t, ReaderContains(mockT, reader, "Hello"))
+	Equal(t, int64(12), reader.Size())
+	Equal(t, 12, reader.Len())
+
+	// for buffer
+	readWriter := bytes.NewBufferString("Hello, World")
+	True(t, ReaderContains(mockT, readWriter, "Hello"))
+	Equal(t, "Hello, World", readWriter.String())
+
+	False(t, ReaderContains(mockT, readWriter, "Earth"))
+	False(t, ReaderContains(mockT, "Hello, World", "Hello"))
+}
+
+func TestReaderNotContains(t *testing.T) {
+	mockT := new(testing.T)
+
+	// for seeker
+	reader := strings.NewReader("Hello, World")
+	False(t, ReaderNotContains(mockT, reader, "Hello"))
+	True(t, ReaderNotContains(mockT, reader, "Earth"))
+
+	// for buffer
+	readWriter := bytes.NewBufferString("Hello, World")
+	False(t, ReaderNotContains(mockT, readWriter, "Hello"))
+	Equal(t, "Hello, World", readWriter.String())
+}
+
+func TestReaderKeepsReadable(t *testing.T) {
+	mockT := new(testing.T)
+
+	// *io.ReadCloser is swapped with buffered data, and closes the original
+	var closed bool
+	body := io.ReadCloser(&closeRecorder{Reader: iotest.OneByteReader(strings.NewReader("Hello, World")), closed: &closed})
+	True(t, ReaderEqual(mockT, &body, "Hello, World"))
+	True(t, ReaderLen(mockT, &body, 12))
+
+	data, err := io.ReadAll(body)
+	Nil(t, err)
+	Equal(t, "Hello, World", string(data))
+	Nil(t, body.Close())
+	True(t, closed)
+
+	// *io.Reader is swapped with buffered data
+	var reader io.Reader = iotest.HalfReader(strings.NewReader(`{"hello": "world"}`))
+	True(t, ReaderJSON(mockT, &reader, `{"hello": "world"}`))
+	True(t, ReaderMatch(mockT, &reader, `^\{"hello"`))
+
+	data, err = io.ReadAll(reader)
+	Nil(t, err)
+	Equal(t, `{"hello": "world"}`, string(data))
+
+	// *os.File is seeked back to the offset rather than written
+	name := filepath.Join(t.TempDir(), "reader.txt")
+	Nil(t, os.WriteFile(name, []byte("Hello, World"), 0o644))
+
+	file, err := os.Open(name)
+	Nil(t, err)
+	defer file.Close()
+
+	_, err = file.Seek(7, io.SeekStart)
+	Nil(t, err)
+	True(t, ReaderEqual(mockT, file, "World"))
+
+	data, err = io.ReadAll(file)
+	Nil(t, err)
+	Equal(t, "World", string(data))
+
+	content, err := os.ReadFile(name)
+	Nil(t, err)
+	Equal(t, "Hello, World", string(content))
+}
+
+func TestReaderLimit(t *testing.T) {
+	bufT := &bufferT{}
+
+	it := New(bufT, WithReaderLimit(5))
+
+	var reader io.Reader = strings.NewReader("Hello, World")
+	False(t, it.ReaderContains(&reader, "Hello"))
+	Contains(t, bufT.buf.String(), "data exceeds limit of 5 bytes")
+
+	// the reader keeps all data even if it exceeds the limit
+	data, err := io.ReadAll(reader)
+	Nil(t, err)
+	Equal(t, "Hello, World", string(data))
+
+	False(t, it.ReaderLen(bytes.NewBufferString("Hello, World"), 12))
+	True(t, New(t, WithReaderLimit(0)).ReaderLen(bytes.NewBufferString("Hello, World"), 12))
+	True(t, New(t, WithReaderLimit(12)).ReaderNotContains(strings.NewReader("Hello, World"), "Earth"))
+}
+
+func TestReaderFailure(t *testing.T) {
+	bufT := &bufferT{}
+	False(t, ReaderLen(bufT, strings.NewReader("Hello"), 3))
+	Contains(t, bufT.buf.String(), "Expected reader of 3 byte(s), but got 5")
+
+	bufT = &bufferT{}
+	False(t, ReaderEqual(bufT, iotest.ErrReader(iotest.ErrTimeout), ""))
+	Contains(t, bufT.buf.String(), "Failed to read from")
+	Contains(t, bufT.buf.String(), iotest.ErrTimeout.Error())
+
+	bufT = &bufferT{}
+	False(t, ReaderJSON(bufT, strings.NewReader(`{"hello": "earth"}`), `{"hello": "world"}`))
+	Contains(t, strings.ReplaceAll(bufT.buf.String(), `\"`, `"`), `/hello: expected "world", but got "earth"`)
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed *bool
+}
+
+func (r *closeRecorder) Close() error {
+	*r.closed = true
+
+	return nil
+}
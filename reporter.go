@@ -0,0 +1,369 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/kr/pretty"
+)
+
+// Reporter reports failures of assertions, it must mark the test failed, i.e. by t.Errorf.
+// Diffs of failures are painted for TextReporter only, which are plain for other Reporters.
+type Reporter interface {
+	Report(t Testing, failure Failure)
+}
+
+// Failure is a structured failure of an assertion.
+type Failure struct {
+	// Test is name of the test, it is empty if the Testing does not implement Name() string.
+	Test string `json:"test,omitempty"`
+
+	// Assertion is name of the assertion failed, i.e. Equal.
+	Assertion string `json:"assertion"`
+
+	Trace   []string `json:"trace,omitempty"`
+	Message string   `json:"message"`
+
+	// Expected, Actual and Diff are reported by assertions comparing values only, i.e. Equal and EqualJSON.
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Diff     string `json:"diff,omitempty"`
+
+	// Extras are the rest labeled contents in order, i.e. Messages.
+	Extras []FailureExtra `json:"extras,omitempty"`
+}
+
+// FailureExtra is a labeled content of the Failure.
+type FailureExtra struct {
+	Label   string `json:"label"`
+	Content string `json:"content"`
+}
+
+// failureValues holds values compared by the assertion for the Failure.
+type failureValues struct {
+	expected string
+	actual   string
+	diff     string
+}
+
+// newFailureValues returns values of the expected and actual with diff of them, i.e. diffObjects.
+func newFailureValues(expected, actual any, diff string) failureValues {
+	es, as := prettifyValues(expected, actual)
+
+	return failureValues{
+		expected: es,
+		actual:   as,
+		diff:     strings.TrimSpace(diff),
+	}
+}
+
+var reporters = newGlobalSettings[Reporter](nil)
+
+// SetReporter sets the Reporter of all failures, nil restores the default text output.
+// A *JUnitReporter writes the JUnit XML file by Close only, which must be called in TestMain.
+//
+//	func TestMain(m *testing.M) {
+//	  reporter := assert.NewJUnitReporter("report.xml", nil)
+//	  assert.SetReporter(reporter)
+//
+//	  code := m.Run()
+//	  if err := reporter.Close(); err != nil {
+//	    fmt.Fprintln(os.Stderr, err)
+//	  }
+//	  os.Exit(code)
+//	}
+func SetReporter(reporter Reporter) {
+	reporters.set(func(settings *Reporter) {
+		*settings = reporter
+	})
+}
+
+// WithReporter sets the Reporter of failures reported through the Assertions,
+// which takes precedence over SetReporter.
+func WithReporter(reporter Reporter) Option {
+	return func(it *Assertions) {
+		it.updateSettings(func(settings *settings) {
+			settings.reporter = &reporter
+		})
+	}
+}
+
+// reporterOf returns the Reporter of the Testing, it is nil for the default text output.
+func reporterOf(t Testing) Reporter {
+	if local := settingsOf(t); local != nil && local.reporter != nil {
+		return *local.reporter
+	}
+
+	return reporters.get()
+}
+
+// newFailure returns the Failure of labeled contents of Fail.
+func newFailure(t Testing, content []labeledContent, values failureValues) Failure {
+	failure := Failure{
+		Assertion: assertionName(),
+		Expected:  values.expected,
+		Actual:    values.actual,
+		Diff:      values.diff,
+	}
+
+	if n, ok := testingOf(t).(namer); ok {
+		failure.Test = n.Name()
+	}
+
+	for _, v := range content {
+		switch v.label {
+		case "Trace":
+			for _, line := range strings.Split(v.content, "\n") {
+				if line = strings.Trim(line, "\r\t "); line != "" {
+					failure.Trace = append(failure.Trace, line)
+				}
+			}
+
+		case "Error":
+			failure.Message = v.content
+
+		default:
+			failure.Extras = append(failure.Extras, FailureExtra{Label: v.label, Content: v.content})
+		}
+	}
+
+	return failure
+}
+
+// content returns labeled contents of the failure in the order of Fail.
+func (f Failure) content() []labeledContent {
+	content := []labeledContent{
+		{"Trace", strings.Join(f.Trace, "\n\r\t\t\t")},
+		{"Error", f.Message},
+	}
+	for _, extra := range f.Extras {
+		content = append(content, labeledContent{extra.Label, extra.Content})
+	}
+
+	return content
+}
+
+// assertionName returns name of the outermost function of assert packages in the stack, i.e. Equal.
+func assertionName() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	name := ""
+	for more := true; more; {
+		var frame runtime.Frame
+
+		frame, more = frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/golib/assert") {
+			break
+		}
+
+		// strip package, receiver and type parameters, i.e. github.com/golib/assert.(*Assertions).Equal
+		fn := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		fn = strings.TrimSuffix(fn[strings.LastIndex(fn, ".")+1:], "[...]")
+		if isTest(fn, "Test") || isTest(fn, "Benchmark") || isTest(fn, "Example") {
+			break
+		}
+
+		// NOTE: closures and unexported functions are skipped, i.e. Group.func1
+		if fn != "" && fn[0] >= 'A' && fn[0] <= 'Z' {
+			name = fn
+		}
+	}
+
+	return name
+}
+
+// TextReporter reports failures in the default labeled text.
+type TextReporter struct{}
+
+// Report reports the failure through Errorf of the Testing.
+func (TextReporter) Report(t Testing, failure Failure) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	reportText(t, failure.content())
+}
+
+// JSONReporter reports failures as JSON lines, diffs of which are plain.
+type JSONReporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONReporter creates a *JSONReporter, failures are written to w if it is not nil, i.e. a file
+// collected by CI, along with Errorf of the Testing.
+func NewJSONReporter(w io.Writer) *JSONReporter {
+	return &JSONReporter{
+		w: w,
+	}
+}
+
+// Report reports the failure as a JSON line.
+func (r *JSONReporter) Report(t Testing, failure Failure) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	data, err := json.Marshal(failure)
+	if err != nil {
+		t.Errorf("%s", pretty.Sprintf("Failed to encode failure %# v: %v", failure, err))
+		return
+	}
+
+	if r.w != nil {
+		r.mu.Lock()
+		_, _ = r.w.Write(append(data, '\n'))
+		r.mu.Unlock()
+	}
+
+	t.Errorf("%s", data)
+}
+
+// JUnitReporter records failures, and reports them with the next Reporter. The JUnit XML file is
+// written by Close only, which MUST be called in TestMain after all tests are run, see SetReporter.
+// NOTE: tests passed are not known by reporters, only tests failed are recorded.
+type JUnitReporter struct {
+	mu       sync.Mutex
+	path     string
+	next     Reporter
+	tests    []string
+	failures map[string][]Failure
+}
+
+// NewJUnitReporter creates a *JUnitReporter writing to the path, failures are reported with the next
+// Reporter as well, which is TextReporter if it is nil.
+//
+// NOTE: nothing is written unless Close is called, there is no hook of the test binary exiting.
+// Call Close in TestMain after m.Run(), as the example of SetReporter.
+func NewJUnitReporter(path string, next Reporter) *JUnitReporter {
+	if next == nil {
+		next = TextReporter{}
+	}
+
+	return &JUnitReporter{
+		path:     path,
+		next:     next,
+		failures: map[string][]Failure{},
+	}
+}
+
+// Report records the failure, and reports it with the next Reporter.
+func (r *JUnitReporter) Report(t Testing, failure Failure) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	r.mu.Lock()
+	if _, ok := r.failures[failure.Test]; !ok {
+		r.tests = append(r.tests, failure.Test)
+	}
+	r.failures[failure.Test] = append(r.failures[failure.Test], failure)
+	r.mu.Unlock()
+
+	r.next.Report(t, failure)
+}
+
+// Close writes failures recorded to the JUnit XML file, the file is written even if no test failed.
+func (r *JUnitReporter) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.flush()
+}
+
+type (
+	junitTestSuites struct {
+		XMLName  xml.Name         `xml:"testsuites"`
+		Tests    int              `xml:"tests,attr"`
+		Failures int              `xml:"failures,attr"`
+		Suites   []junitTestSuite `xml:"testsuite,omitempty"`
+	}
+
+	junitTestSuite struct {
+		Name     string          `xml:"name,attr"`
+		Tests    int             `xml:"tests,attr"`
+		Failures int             `xml:"failures,attr"`
+		Cases    []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string         `xml:"name,attr"`
+		Classname string         `xml:"classname,attr"`
+		Failures  []junitFailure `xml:"failure"`
+	}
+
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Text    string `xml:",chardata"`
+	}
+)
+
+// flush writes the file with failures recorded, it must be called with lock held.
+func (r *JUnitReporter) flush() error {
+	suite := junitTestSuite{
+		Name:  strings.TrimSuffix(filepath.Base(os.Args[0]), ".test"),
+		Tests: len(r.tests),
+	}
+
+	for _, test := range r.tests {
+		testCase := junitTestCase{
+			Name:      test,
+			Classname: suite.Name,
+		}
+
+		for _, failure := range r.failures[test] {
+			text := new(bytes.Buffer)
+			text.WriteString(labeledText(failure.content()...))
+			if failure.Expected != "" || failure.Actual != "" {
+				fmt.Fprintf(text, "Expected: %s\nActual:   %s\n", failure.Expected, failure.Actual)
+			}
+
+			testCase.Failures = append(testCase.Failures, junitFailure{
+				Message: strings.SplitN(failure.Message, "\n", 2)[0],
+				Type:    failure.Assertion,
+				Text:    text.String(),
+			})
+		}
+
+		// NOTE: failures of JUnit are test cases failed rather than assertions failed
+		if len(testCase.Failures) > 0 {
+			suite.Failures++
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	suites := junitTestSuites{
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+	}
+	if len(suite.Cases) > 0 {
+		suites.Suites = []junitTestSuite{suite}
+	}
+
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	// NOTE: write to a temporary file first, the file is never left truncated
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, append([]byte(xml.Header), data...), 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, r.path)
+}
@@ -0,0 +1,186 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// recordReporter records failures and fails the test with messages.
+type recordReporter struct {
+	failures []Failure
+}
+
+func (r *recordReporter) Report(t Testing, failure Failure) {
+	r.failures = append(r.failures, failure)
+
+	t.Errorf("%s", failure.Message)
+}
+
+func Test_WithReporter(t *testing.T) {
+	reporter := &recordReporter{}
+
+	mockT := &mockNamedTesting{name: "TestUser"}
+	it := New(mockT, WithReporter(reporter))
+
+	False(t, it.Equal("want", "got", "hello, %s!", "world"))
+	False(t, it.Contains("Hello, World", "Earth"))
+
+	if Len(t, reporter.failures, 2) {
+		failure := reporter.failures[0]
+		Equal(t, "TestUser", failure.Test)
+		Equal(t, "Equal", failure.Assertion)
+		Contains(t, failure.Message, "Expected values are NOT equal.")
+		Equal(t, `"want"`, failure.Expected)
+		Equal(t, `"got"`, failure.Actual)
+		Contains(t, failure.Diff, "--- Expected")
+		Equal(t, []FailureExtra{{Label: "Messages", Content: "hello, world!"}}, failure.Extras)
+
+		Equal(t, "Contains", reporter.failures[1].Assertion)
+		Empty(t, reporter.failures[1].Expected)
+	}
+	Contains(t, mockT.buf.String(), "Expected values are NOT equal.")
+
+	// the Reporter applies to the Assertions only, neither plain calls nor other Assertions of the Testing
+	mockT.buf.Reset()
+	False(t, Equal(mockT, "want", "got"))
+	False(t, New(mockT).Equal("want", "got"))
+	Len(t, reporter.failures, 2)
+	Equal(t, 2, strings.Count(mockT.buf.String(), "Expected values are NOT equal."))
+
+	// the Testing is not required to be comparable
+	other := &recordReporter{}
+	False(t, New(uncomparableT{bufferT: &bufferT{}}, WithReporter(other)).True(false))
+	Len(t, other.failures, 1)
+}
+
+// uncomparableT is a Testing which cannot be used as a key of map.
+type uncomparableT struct {
+	*bufferT
+
+	_ []func()
+}
+
+func Test_SetReporter(t *testing.T) {
+	reporter := &recordReporter{}
+
+	SetReporter(reporter)
+	defer SetReporter(nil)
+
+	bufT := &bufferT{}
+	False(t, EqualJSON(bufT, `{"id": 1}`, `{"id": 2}`))
+	False(t, Group(bufT, "user", func(g *Assertions) {
+		g.Equal(1, 2)
+	}))
+
+	if Len(t, reporter.failures, 2) {
+		Equal(t, "EqualJSON", reporter.failures[0].Assertion)
+		Equal(t, "/id: expected 1, but got 2", reporter.failures[0].Diff)
+		Contains(t, reporter.failures[0].Expected, `"id": 1`)
+
+		Equal(t, "Group", reporter.failures[1].Assertion)
+		Equal(t, "#1", reporter.failures[1].Extras[0].Label)
+	}
+}
+
+func Test_TextReporter(t *testing.T) {
+	expected, actual := &bufferT{}, &bufferT{}
+
+	False(t, Fail(expected, "failed", "hello, %s!", "world"))
+	False(t, New(actual, WithReporter(TextReporter{})).Fail("failed", "hello, %s!", "world"))
+
+	// NOTE: file:line prefix of bufferT differs by the caller
+	trim := func(s string) string {
+		return s[strings.Index(s, "\tTrace:"):]
+	}
+	Equal(t, trim(expected.buf.String()), trim(actual.buf.String()))
+
+	mockT := &mockTBTesting{}
+	New(mockT, WithReporter(TextReporter{})).Equal(1, 2)
+	NotContains(t, mockT.buf.String(), "\r")
+	Contains(t, mockT.buf.String(), "\tError:")
+}
+
+func Test_JSONReporter(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	mockT := &mockNamedTesting{name: "TestJSON"}
+	defer mockT.cleanup()
+	False(t, New(mockT, WithReporter(NewJSONReporter(buf))).Equal("want", "got"))
+
+	var failure Failure
+	Nil(t, json.Unmarshal(buf.Bytes(), &failure))
+	Equal(t, "TestJSON", failure.Test)
+	Equal(t, "Equal", failure.Assertion)
+	NotContains(t, failure.Diff, "\x1b[")
+	Contains(t, failure.Diff, `-"want"`)
+
+	Equal(t, 1, strings.Count(buf.String(), "\n"))
+	Contains(t, mockT.buf.String(), `"assertion":"Equal"`)
+}
+
+func Test_JUnitReporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reports", "junit.xml")
+	reporter := NewJUnitReporter(path, NewJSONReporter(nil))
+
+	mockT := &mockNamedTesting{name: "TestFirst"}
+	defer mockT.cleanup()
+	it := New(mockT, WithReporter(reporter))
+	it.Equal(1, 2, "first")
+	it.True(false)
+
+	otherT := &mockNamedTesting{name: "TestSecond"}
+	defer otherT.cleanup()
+	New(otherT, WithReporter(reporter)).Contains("Hello", "Earth")
+
+	Contains(t, mockT.buf.String(), `"assertion":"Equal"`)
+
+	// NOTE: the file is written by Close only
+	_, err := os.Stat(path)
+	True(t, os.IsNotExist(err))
+	Nil(t, reporter.Close())
+
+	data, err := os.ReadFile(path)
+	Nil(t, err)
+	True(t, strings.HasPrefix(string(data), xml.Header))
+
+	var suites junitTestSuites
+	Nil(t, xml.Unmarshal(data, &suites))
+	Equal(t, 2, suites.Tests)
+
+	// NOTE: failures are test cases failed, NOT assertions failed
+	Equal(t, 2, suites.Failures)
+	if Len(t, suites.Suites, 1) && Len(t, suites.Suites[0].Cases, 2) {
+		Equal(t, 2, suites.Suites[0].Tests)
+		Equal(t, 2, suites.Suites[0].Failures)
+
+		first := suites.Suites[0].Cases[0]
+		Equal(t, "TestFirst", first.Name)
+		if Len(t, first.Failures, 2) {
+			Equal(t, "Equal", first.Failures[0].Type)
+			Equal(t, "Expected values are NOT equal.", first.Failures[0].Message)
+			Contains(t, first.Failures[0].Text, "Messages: first")
+			Contains(t, first.Failures[0].Text, "Expected: 1")
+			NotContains(t, first.Failures[0].Text, "\x1b[")
+			Equal(t, "True", first.Failures[1].Type)
+		}
+
+		Equal(t, "TestSecond", suites.Suites[0].Cases[1].Name)
+	}
+}
+
+func Test_JUnitReporterWithoutFailures(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	reporter := NewJUnitReporter("junit.xml", nil)
+
+	Nil(t, reporter.Close())
+
+	data, err := os.ReadFile("junit.xml")
+	Nil(t, err)
+	Equal(t, xml.Header+`<testsuites tests="0" failures="0"></testsuites>`, string(data))
+}
@@ -0,0 +1,109 @@
+package assert
+
+import (
+	"sync"
+)
+
+// globalSettings holds settings of S applied to failures of all Testing.
+type globalSettings[S any] struct {
+	sync.RWMutex
+
+	settings S
+}
+
+func newGlobalSettings[S any](settings S) *globalSettings[S] {
+	return &globalSettings[S]{
+		settings: settings,
+	}
+}
+
+// set updates the global settings.
+func (s *globalSettings[S]) set(update func(settings *S)) {
+	s.Lock()
+	update(&s.settings)
+	s.Unlock()
+}
+
+// get returns the global settings.
+func (s *globalSettings[S]) get() S {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.settings
+}
+
+// settings holds settings of failures reported through an Assertions set by Options,
+// which take precedence over the global settings.
+type settings struct {
+	fast     bool
+	trace    traceSettings
+	color    colorSettings
+	reporter *Reporter
+}
+
+// helperTesting is the Testing which implements Helper, i.e. *testing.T.
+type helperTesting interface {
+	Testing
+	tHelper
+}
+
+// settingsT is the Testing of Assertions carrying settings down to Fail.
+//
+// NOTE: methods of the Testing are promoted, so that the wrapper is skipped by t.Helper().
+type settingsT struct {
+	Testing
+
+	settings *settings
+}
+
+// settingsHelperT is the same as settingsT, except it keeps Helper of the Testing.
+type settingsHelperT struct {
+	helperTesting
+
+	settings *settings
+}
+
+// withSettings returns the Testing carrying the settings, or the Testing if there are no settings.
+func withSettings(t Testing, s *settings) Testing {
+	if s == nil {
+		return t
+	}
+
+	t = testingOf(t)
+	if h, ok := t.(helperTesting); ok {
+		return &settingsHelperT{helperTesting: h, settings: s}
+	}
+
+	return &settingsT{Testing: t, settings: s}
+}
+
+// settingsOf returns settings carried by the Testing, it is nil for Testing of plain calls.
+func settingsOf(t Testing) *settings {
+	switch t := t.(type) {
+	case *settingsT:
+		return t.settings
+	case *settingsHelperT:
+		return t.settings
+	}
+
+	return nil
+}
+
+// isFailFast returns whether the Testing quits once a failure is reported.
+func isFailFast(t Testing) bool {
+	s := settingsOf(t)
+
+	return s != nil && s.fast
+}
+
+// testingOf returns the Testing wrapped with settings, which implements optional methods, i.e. Name and Cleanup.
+func testingOf(t Testing) Testing {
+	switch t := t.(type) {
+	case *settingsT:
+		return t.Testing
+	case *settingsHelperT:
+		return t.helperTesting
+	}
+
+	return t
+}
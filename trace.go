@@ -0,0 +1,344 @@
+package assert
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// FullTrace is the trace depth of all callers from the assertion to the test.
+const FullTrace = 0
+
+// traceSettings is settings of traces for the Testing, depth not set falls back to the global.
+type traceSettings struct {
+	depth    *int
+	packages []string
+}
+
+// traceModule is the module a source file belongs to.
+type traceModule struct {
+	root string
+	path string
+}
+
+var (
+	// NOTE: callers of github.com/golib/assert and its subpackages are always skipped
+	assertPackages = []string{
+		"github.com/golib/assert.",
+		"github.com/golib/assert/asserttest.",
+		"github.com/golib/assert/httpassert.",
+		"github.com/golib/assert/match.",
+	}
+
+	traces = newGlobalSettings(traceSettings{
+		packages: assertPackages,
+	})
+
+	// helperFunctions holds functions marked by Helper
+	helperFunctions sync.Map
+
+	// traceModules caches modules of source directories
+	traceModules sync.Map
+
+	// NOTE: -trimpath builds have source files prefixed by module path rather than directory
+	mainModulePath = sync.OnceValue(func() string {
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			return ""
+		}
+
+		return info.Main.Path
+	})
+)
+
+// SetTraceDepth sets max callers of traces of all failures, FullTrace reports all callers from
+// the assertion to the test. It is 1 by default, which is the caller of the assertion.
+func SetTraceDepth(depth int) {
+	traces.set(func(settings *traceSettings) {
+		settings.depth = &depth
+	})
+}
+
+// WithTraceDepth sets max callers of traces of failures reported through the Assertions,
+// which takes precedence over SetTraceDepth.
+func WithTraceDepth(depth int) Option {
+	return func(it *Assertions) {
+		it.updateSettings(func(settings *settings) {
+			settings.trace.depth = &depth
+		})
+	}
+}
+
+// WithHelperPackages skips callers of packages or functions prefixed by any of prefixes in traces
+// of failures reported through the Assertions, i.e. "github.com/my/project/internal/testkit.".
+// Prefixes are normalised as RegisterHelperPackage. Callers of packages registered by
+// RegisterHelperPackage are skipped as well.
+func WithHelperPackages(prefixes ...string) Option {
+	return func(it *Assertions) {
+		it.updateSettings(func(settings *settings) {
+			for _, prefix := range prefixes {
+				settings.trace.packages = append(settings.trace.packages, helperPrefix(prefix))
+			}
+		})
+	}
+}
+
+// RegisterHelperPackage skips callers of functions prefixed by the prefix in traces of all failures,
+// i.e. "github.com/my/project/internal/testkit.". Callers of github.com/golib/assert and its subpackages
+// are always skipped.
+//
+// Prefixes are matched against full names of functions, i.e. "github.com/my/project/internal/testkit.Expect".
+// A path of package without trailing "." or "/" is normalised with ".", so that "…/testkit" skips the
+// package only rather than "…/testkit2" as well, use "…/testkit/" to skip packages under it. Prefixes
+// of functions, i.e. "…/testkit.expect", are kept as is.
+func RegisterHelperPackage(prefix string) {
+	prefix = helperPrefix(prefix)
+
+	traces.set(func(settings *traceSettings) {
+		// NOTE: copy on write, packages of settings got are shared without lock
+		settings.packages = append(settings.packages[:len(settings.packages):len(settings.packages)], prefix)
+	})
+}
+
+// resetHelperPackages drops packages registered by RegisterHelperPackage, which is for tests.
+func resetHelperPackages() {
+	traces.set(func(settings *traceSettings) {
+		settings.packages = assertPackages
+	})
+}
+
+// helperPrefix normalises the prefix of helper packages, a path of package is suffixed by ".".
+func helperPrefix(prefix string) string {
+	if prefix == "" || strings.HasSuffix(prefix, ".") || strings.HasSuffix(prefix, "/") {
+		return prefix
+	}
+
+	// NOTE: names of functions are qualified by the last element of package path, i.e. testkit.Expect
+	if strings.Contains(prefix[strings.LastIndex(prefix, "/")+1:], ".") {
+		return prefix
+	}
+
+	return prefix + "."
+}
+
+// Helper marks the calling function as a helper skipped in traces of failures, like t.Helper() of testing.
+// Unlike t.Helper(), it applies to all tests, wrappers of assertions at any depth can be marked.
+//
+//	func assertUser(t *testing.T, user *User) {
+//	  t.Helper()
+//	  assert.Helper()
+//
+//	  assert.Equal(t, "golib", user.Name)
+//	}
+func Helper() {
+	var pcs [1]uintptr
+	if runtime.Callers(2, pcs[:]) == 0 {
+		return
+	}
+
+	frame, _ := runtime.CallersFrames(pcs[:]).Next()
+	if frame.Function != "" {
+		helperFunctions.Store(frame.Function, struct{}{})
+	}
+}
+
+// traceOf returns traceSettings carried by the Testing merged with the global.
+func traceOf(t Testing) traceSettings {
+	settings := traces.get()
+	if local := settingsOf(t); local != nil {
+		if local.trace.depth != nil {
+			settings.depth = local.trace.depth
+		}
+
+		settings.packages = append(settings.packages[:len(settings.packages):len(settings.packages)], local.trace.packages...)
+	}
+
+	return settings
+}
+
+// isHelper returns whether the function is of helper packages skipped in traces.
+func (s traceSettings) isHelper(name string) bool {
+	if _, ok := helperFunctions.Load(name); ok {
+		return true
+	}
+
+	for _, prefix := range s.packages {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StackTraces is necessary because the assert func use the testing object
+// internally, causing it to print the file:line of the assert method,
+// rather than where the problem actually occurred in calling code.
+//
+// StackTraces returns an array of strings containing the file and line number
+// of each stack frame leading from the current test to the assert call that
+// failed, up to the depth of SetTraceDepth. Files are relative to the root of
+// their modules, which are prefixed by module path if not the main module.
+func StackTraces() []string {
+	return stackTraces(traceOf(nil))
+}
+
+func stackTraces(settings traceSettings) []string {
+	pcs := make([]uintptr, 64)
+	for {
+		n := runtime.Callers(2, pcs)
+		if n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+
+	var callers []string
+
+	frames := runtime.CallersFrames(pcs)
+	for more := len(pcs) > 0; more; {
+		var frame runtime.Frame
+
+		frame, more = frames.Next()
+
+		// This is a huge edge case, but it will panic if this is the case.
+		if frame.File == "<autogenerated>" || frame.Function == "" {
+			break
+		}
+
+		// testing.tRunner is the standard library function that calls
+		// tests. Subtests are called directly by tRunner, without going through
+		// the Test/Benchmark/Example function that contains the t.Run calls, so
+		// with subtests we should break when we hit tRunner, without adding it
+		// to the list of callers.
+		if frame.Function == "testing.tRunner" {
+			break
+		}
+
+		if settings.isHelper(frame.Function) {
+			continue
+		}
+
+		// ignore golang packages
+		if file, ok := tracePath(frame.File); ok {
+			callers = append(callers, fmt.Sprintf("%s:%d", file, frame.Line))
+		}
+
+		// Drop the package
+		segments := strings.Split(frame.Function, ".")
+		name := segments[len(segments)-1]
+		if isTest(name, "Test") ||
+			isTest(name, "Benchmark") ||
+			isTest(name, "Example") {
+			break
+		}
+	}
+
+	depth := 1
+	if settings.depth != nil {
+		depth = *settings.depth
+	}
+	if depth > FullTrace && len(callers) > depth {
+		return callers[:depth]
+	}
+
+	return callers
+}
+
+// tracePath returns path of the source file in traces, which is false for files of golang.
+func tracePath(file string) (string, bool) {
+	if module, ok := moduleOf(filepath.Dir(file)); ok {
+		if module.path == "std" || module.path == "cmd" {
+			return "", false
+		}
+
+		rel, err := filepath.Rel(module.root, file)
+		if err == nil {
+			rel = filepath.ToSlash(rel)
+			if module.path != mainModulePath() {
+				rel = module.path + "/" + rel
+			}
+
+			return rel, true
+		}
+	}
+
+	if mainPath := mainModulePath(); mainPath != "" && strings.HasPrefix(file, mainPath+"/") {
+		return strings.TrimPrefix(file, mainPath+"/"), true
+	}
+
+	// NOTE: files of -trimpath builds without module root, golang packages have no domain, i.e. runtime/panic.go
+	paths := strings.Split(filepath.ToSlash(file), "/")
+	if !filepath.IsAbs(file) && !strings.Contains(paths[0], ".") {
+		return "", false
+	}
+
+	if len(paths) < 2 {
+		return "", false
+	}
+
+	return strings.Join(paths[len(paths)-2:], "/"), true
+}
+
+// moduleOf returns the module of the directory by the nearest go.mod.
+func moduleOf(dir string) (traceModule, bool) {
+	if cached, ok := traceModules.Load(dir); ok {
+		module, _ := cached.(*traceModule)
+		if module == nil {
+			return traceModule{}, false
+		}
+
+		return *module, true
+	}
+
+	var module *traceModule
+	if filepath.IsAbs(dir) {
+		for root := dir; ; {
+			if path, ok := readModulePath(filepath.Join(root, "go.mod")); ok {
+				module = &traceModule{
+					root: root,
+					path: path,
+				}
+				break
+			}
+
+			parent := filepath.Dir(root)
+			if parent == root {
+				break
+			}
+			root = parent
+		}
+	}
+
+	traceModules.Store(dir, module)
+	if module == nil {
+		return traceModule{}, false
+	}
+
+	return *module, true
+}
+
+// readModulePath returns path of the module declared by the go.mod file.
+func readModulePath(filename string) (string, bool) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", false
+	}
+	defer file.Close()
+
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,164 @@
+package assert_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/golib/assert"
+	"github.com/golib/assert/asserttest"
+)
+
+// NOTE: callers of package assert are skipped in traces, tests of traces are in package assert_test.
+
+// lineOf returns the trace of its caller, i.e. trace_test.go:21. Assertions failed as arguments
+// of lineOf are of the same line.
+func lineOf(...any) string {
+	_, file, line, _ := runtime.Caller(1)
+
+	return fmt.Sprintf("%s:%d", filepath.Base(file), line)
+}
+
+// failInHelper fails with the Assertions, and returns the trace of the failure up to the helper.
+func failInHelper(it *assert.Assertions) []string {
+	return []string{lineOf(it.Fail("failed"))}
+}
+
+func failInNestedHelper(it *assert.Assertions) []string {
+	return append(failInHelper(it), lineOf())
+}
+
+func Test_StackTraces(t *testing.T) {
+	traces, line := assert.StackTraces(), lineOf()
+
+	assert.Equal(t, []string{line}, traces)
+}
+
+func Test_StackTracesOfModuleRoot(t *testing.T) {
+	// paths are relative to the module root, regardless of the working directory
+	t.Chdir(t.TempDir())
+
+	traces, line := assert.StackTraces(), lineOf()
+	assert.Equal(t, []string{line}, traces)
+
+	dir, err := filepath.Abs("module")
+	assert.Nil(t, err)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "pkg"), 0o755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/module\n"), 0o644))
+
+	for _, tc := range []struct {
+		file string
+		path string
+		ok   bool
+	}{
+		{filepath.Join(dir, "pkg", "helper.go"), "example.com/module/pkg/helper.go", true},
+		{filepath.Join(dir, "helper.go"), "example.com/module/helper.go", true},
+
+		// files of -trimpath builds are prefixed by module path rather than directory
+		{"github.com/golib/assert/trace_test.go", "trace_test.go", true},
+		{"github.com/golib/assert/match/match.go", "match/match.go", true},
+		{"example.com/other/pkg/helper.go", "pkg/helper.go", true},
+		{"runtime/panic.go", "", false},
+	} {
+		path, ok := assert.TracePath(tc.file)
+		assert.Equal(t, tc.ok, ok, "file %s", tc.file)
+		assert.Equal(t, tc.path, path, "file %s", tc.file)
+	}
+}
+
+func Test_WithTraceDepth(t *testing.T) {
+	rec := asserttest.NewRecorder(t)
+
+	trace := failInNestedHelper(assert.New(rec))
+	assert.Equal(t, trace[:1], rec.Failures()[0].Trace)
+
+	rec = asserttest.NewRecorder(t)
+
+	trace = append(failInNestedHelper(assert.New(rec, assert.WithTraceDepth(assert.FullTrace))), lineOf())
+	assert.Len(t, trace, 3)
+	assert.Equal(t, trace, rec.Failures()[0].Trace)
+
+	rec = asserttest.NewRecorder(t)
+
+	trace = failInNestedHelper(assert.New(rec, assert.WithTraceDepth(2)))
+	assert.Equal(t, trace, rec.Failures()[0].Trace)
+
+	// the trace depth applies to the Assertions only
+	trace = failInNestedHelper(assert.New(rec))
+	assert.Equal(t, trace[:1], rec.Failures()[1].Trace)
+}
+
+func Test_SetTraceDepth(t *testing.T) {
+	assert.SetTraceDepth(assert.FullTrace)
+	defer assert.SetTraceDepth(1)
+
+	var trace []string
+
+	rec := asserttest.NewRecorder(t)
+	t.Run("subtest", func(t *testing.T) {
+		trace = append(failInNestedHelper(assert.New(rec)), lineOf())
+	})
+	assert.Equal(t, trace, rec.Failures()[0].Trace)
+}
+
+func Test_WithHelperPackages(t *testing.T) {
+	rec := asserttest.NewRecorder(t)
+	it := assert.New(rec, assert.WithTraceDepth(assert.FullTrace), assert.WithHelperPackages("github.com/golib/assert_test.failIn"))
+
+	_, line := failInNestedHelper(it), lineOf()
+	assert.Equal(t, []string{line}, rec.Failures()[0].Trace)
+}
+
+func failInMarkedHelper(it *assert.Assertions) []string {
+	assert.Helper()
+
+	return append(failInHelper(it), lineOf())
+}
+
+func failInRegisteredHelper(it *assert.Assertions) []string {
+	return append(failInMarkedHelper(it), lineOf())
+}
+
+func Test_Helper(t *testing.T) {
+	rec := asserttest.NewRecorder(t)
+	it := assert.New(rec, assert.WithTraceDepth(assert.FullTrace), assert.WithHelperPackages("github.com/golib/assert_test.failInHelper"))
+
+	_, line := failInMarkedHelper(it), lineOf()
+	assert.Equal(t, []string{line}, rec.Failures()[0].Trace)
+}
+
+func Test_RegisterHelperPackage(t *testing.T) {
+	t.Cleanup(assert.ResetHelperPackages)
+
+	assert.RegisterHelperPackage("github.com/golib/assert_test.failInRegisteredHelper")
+
+	rec := asserttest.NewRecorder(t)
+	trace := failInRegisteredHelper(assert.New(rec))
+	assert.Equal(t, trace[:1], rec.Failures()[0].Trace)
+
+	rec = asserttest.NewRecorder(t)
+	it := assert.New(rec, assert.WithHelperPackages("github.com/golib/assert_test.failInHelper"))
+
+	_, line := failInRegisteredHelper(it), lineOf()
+	assert.Equal(t, []string{line}, rec.Failures()[0].Trace)
+}
+
+func Test_RegisterHelperPackageOfPath(t *testing.T) {
+	t.Cleanup(assert.ResetHelperPackages)
+
+	// NOTE: the path of package is normalised with ".", which doesn't match github.com/golib/assert_test
+	assert.RegisterHelperPackage("github.com/golib/assert_")
+
+	rec := asserttest.NewRecorder(t)
+	trace := failInHelper(assert.New(rec))
+	assert.Equal(t, trace, rec.Failures()[0].Trace)
+
+	assert.ResetHelperPackages()
+	assert.RegisterHelperPackage("github.com/golib/assert_test")
+
+	rec = asserttest.NewRecorder(t)
+	failInHelper(assert.New(rec))
+	assert.Empty(t, rec.Failures()[0].Trace)
+}
@@ -6,9 +6,36 @@ type (
 		Errorf(format string, args ...interface{})
 	}
 
+	// NOTE: the interfaces below are implemented by testing.TB, they are detected at runtime
+	// for keeping backwards compatibility with minimal Testing implementers.
+
 	failNower interface {
 		FailNow()
 	}
+
+	tHelper interface {
+		Helper()
+	}
+
+	logger interface {
+		Logf(format string, args ...interface{})
+	}
+
+	skipper interface {
+		Skip(args ...interface{})
+	}
+
+	namer interface {
+		Name() string
+	}
+
+	cleaner interface {
+		Cleanup(func())
+	}
+
+	failureCollector interface {
+		collectFailure(content []labeledContent)
+	}
 )
 
 type (
@@ -19,3 +46,12 @@ type (
 	// methods, and represents a simple func that takes no arguments, and returns nothing.
 	PanicTestFunc func()
 )
+
+type (
+	// Number is the constraint of integer and float types, i.e. int, float64 and time.Duration.
+	Number interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+			~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+			~float32 | ~float64
+	}
+)